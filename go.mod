@@ -7,4 +7,5 @@ toolchain go1.22.1
 require (
 	golang.org/x/exp v0.0.0-20240909161429-701f63a606c0
 	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,33 @@
+package stdlib
+
+import "sync/atomic"
+
+// Atomic is a simple struct that wraps an `atomic.Value` to provide
+// type safe atomic access to a value of type T.
+//
+// The zero value is ready to use and loads the zero value of T.
+type Atomic[T any] struct {
+	// value stores an atomicBox[T] so the concrete type is always consistent,
+	// even when T is an interface type or nil.
+	value atomic.Value
+}
+
+// atomicBox wraps values stored in an Atomic.
+type atomicBox[T any] struct{ t T }
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	box, _ := a.value.Load().(atomicBox[T])
+	return box.t
+}
+
+// Store sets the current value.
+func (a *Atomic[T]) Store(t T) {
+	a.value.Store(atomicBox[T]{t})
+}
+
+// Swap sets the current value and returns the previous value.
+func (a *Atomic[T]) Swap(t T) T {
+	box, _ := a.value.Swap(atomicBox[T]{t}).(atomicBox[T])
+	return box.t
+}
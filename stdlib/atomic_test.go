@@ -0,0 +1,38 @@
+package stdlib_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestAtomic(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var a stdlib.Atomic[error]
+	test.Equal(a.Load(), nil)
+
+	a.Store(errA)
+	test.Equal(a.Swap(nil), error(errA))
+	test.Equal(a.Load(), nil)
+}
+
+func TestAtomic_Concurrent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var a stdlib.Atomic[int]
+	var wg sync.WaitGroup
+	for i := 1; i <= 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			a.Store(i)
+			_ = a.Load()
+		}(i)
+	}
+	wg.Wait()
+
+	test.True(a.Load() > 0, "a stored value must be loaded")
+}
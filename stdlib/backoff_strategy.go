@@ -0,0 +1,11 @@
+//go:generate go-enum --marshal --names
+package stdlib
+
+// BackoffStrategy represents how the delay between retries grows.
+//
+// constant: Delay is the same for every attempt.
+// linear: Delay grows linearly with each attempt.
+// exponential: Delay doubles with each attempt.
+//
+// ENUM(constant, linear, exponential).
+type BackoffStrategy string
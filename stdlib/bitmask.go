@@ -1,6 +1,9 @@
 package stdlib
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 // ParseBitmask creates a new Bitmask from a binary string.
 func ParseBitmask(binary string) (Bitmask, error) {
@@ -22,6 +25,16 @@ func (b Bitmask) MarshalText() ([]byte, error) {
 	return []byte(b.String()), nil
 }
 
+// UnmarshalText implements the text unmarshaller method.
+func (b *Bitmask) UnmarshalText(text []byte) error {
+	v, err := ParseBitmask(string(text))
+	if err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
 // String returns the Bitmask in binary string (001101010) form.
 func (b Bitmask) String() string {
 	return strconv.FormatUint(uint64(b), 2)
@@ -46,3 +59,26 @@ func (b Bitmask) Set(bits Bitmask) Bitmask {
 func (b Bitmask) Toggle(bits Bitmask) Bitmask {
 	return b ^ bits
 }
+
+// FormatBitmask returns the names of all bits set in the Bitmask joined
+// by '|', e.g. "retryable|timeout", using the given registry of names.
+//
+// Bits without a registered name are output as their numeric value and
+// a zero Bitmask is output as "0".
+func FormatBitmask(b Bitmask, registry map[Bitmask]string) string {
+	if b == 0 {
+		return "0"
+	}
+	var names []string
+	for bit := Bitmask(1); bit != 0; bit <<= 1 {
+		if !b.Has(bit) {
+			continue
+		}
+		name, ok := registry[bit]
+		if !ok {
+			name = strconv.FormatUint(uint64(bit), 10)
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,56 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestFormatBitmask(t *testing.T) {
+	stdtest.Table[stdlib.Bitmask, string]{
+		"zero":           {Got: 0, Want: "0"},
+		"single flag":    {Got: stdlib.ErrorFlagRetryable, Want: "retryable"},
+		"multiple flags": {Got: stdlib.ErrorFlagRetryable | stdlib.ErrorFlagTimeout, Want: "retryable|timeout"},
+		"unknown flag":   {Got: stdlib.ErrorFlagUnknown | 1<<7, Want: "unknown|128"},
+		"only unknown":   {Got: 1 << 6, Want: "64"},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Bitmask, string]) {
+		t.Equal(stdlib.FormatBitmask(tc.Got, stdlib.ErrorFlagRegistry), tc.Want)
+	})
+}
+
+func TestBitmask_MarshalText(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	want := stdlib.ErrorFlagRetryable | stdlib.ErrorFlagTimeout
+	b, err := want.MarshalText()
+	test.OK(err)
+	test.Equal(string(b), want.String())
+
+	got, err := stdlib.ParseBitmask(string(b))
+	test.OK(err)
+	test.Equal(got, want)
+}
+
+func TestBitmask_UnmarshalText(t *testing.T) {
+	stdtest.Table[stdlib.Bitmask, stdlib.Bitmask]{
+		"zero":     {Got: 0, Want: 0},
+		"single":   {Got: stdlib.ErrorFlagRetryable, Want: stdlib.ErrorFlagRetryable},
+		"multiple": {Got: stdlib.ErrorFlagRetryable | stdlib.ErrorFlagTimeout, Want: stdlib.ErrorFlagRetryable | stdlib.ErrorFlagTimeout},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Bitmask, stdlib.Bitmask]) {
+		b, err := tc.Got.MarshalText()
+		t.OK(err)
+
+		var got stdlib.Bitmask
+		t.OK(got.UnmarshalText(b))
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestBitmask_UnmarshalText_Invalid(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	got := stdlib.ErrorFlagTimeout
+	test.NotOK(got.UnmarshalText([]byte("retryable")))
+	test.Equal(got, stdlib.ErrorFlagTimeout)
+}
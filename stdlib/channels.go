@@ -0,0 +1,286 @@
+package stdlib
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SliceToChannel returns a buffered channel that receives all items
+// from the given slice and is closed once all items are sent.
+//
+// Sending stops early if the context is cancelled.
+func SliceToChannel[T any](ctx context.Context, input []T) <-chan T {
+	output := make(chan T, len(input))
+	go func() {
+		defer close(output)
+		for _, item := range input {
+			select {
+			case <-ctx.Done():
+				return
+			case output <- item:
+			}
+		}
+	}()
+	return output
+}
+
+// ChannelToSlice drains the given channel into a slice until it is closed.
+//
+// If the context is cancelled first, the items received so far are returned
+// along with the context error.
+func ChannelToSlice[T any](ctx context.Context, input <-chan T) ([]T, error) {
+	var output []T
+	for {
+		select {
+		case <-ctx.Done():
+			return output, ctx.Err()
+		case item, ok := <-input:
+			if !ok {
+				return output, nil
+			}
+			output = append(output, item)
+		}
+	}
+}
+
+// ChannelMap returns a channel with the results from the given 'map' function
+// applied to each item received from the input channel.
+//
+// The output channel is closed when the input channel is closed or the
+// context is cancelled.
+func ChannelMap[TIn any, TOut any](ctx context.Context, input <-chan TIn, mapper Mapper[TIn, TOut]) <-chan TOut {
+	output := make(chan TOut)
+	go func() {
+		defer close(output)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case item, ok := <-input:
+				if !ok {
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case output <- mapper(item):
+				}
+			}
+		}
+	}()
+	return output
+}
+
+// ChannelFilter returns a channel containing only items from the
+// input channel that match the predicate function.
+//
+// The output channel is closed when the input channel is closed or the
+// context is cancelled.
+func ChannelFilter[T any](ctx context.Context, input <-chan T, predicate Predicate[T]) <-chan T {
+	output := make(chan T)
+	go func() {
+		defer close(output)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case item, ok := <-input:
+				if !ok {
+					return
+				}
+				if !predicate(item) {
+					continue
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case output <- item:
+				}
+			}
+		}
+	}()
+	return output
+}
+
+// ChannelFanOut returns n channels that each receive every item from
+// the input channel (broadcast).
+//
+// Items are delivered to each output in order, so a slow consumer will
+// block the others. All output channels are closed when the input channel
+// is closed or the context is cancelled.
+//
+// It panics if n is negative.
+func ChannelFanOut[T any](ctx context.Context, input <-chan T, n int) []<-chan T {
+	if n < 0 {
+		panic(fmt.Sprintf("ChannelFanOut[%T] received n=%d; must not be negative", input, n))
+	}
+	outputs := make([]chan T, n)
+	for i := range outputs {
+		outputs[i] = make(chan T)
+	}
+	go func() {
+		defer func() {
+			for _, output := range outputs {
+				close(output)
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case item, ok := <-input:
+				if !ok {
+					return
+				}
+				for _, output := range outputs {
+					select {
+					case <-ctx.Done():
+						return
+					case output <- item:
+					}
+				}
+			}
+		}
+	}()
+	return SliceMap(outputs, func(c chan T) <-chan T { return c })
+}
+
+// ChannelFanIn returns a channel that receives all items from the given
+// input channels (merge).
+//
+// The output channel is closed when all input channels are closed or the
+// context is cancelled.
+func ChannelFanIn[T any](ctx context.Context, inputs ...<-chan T) <-chan T {
+	output := make(chan T)
+
+	var wg sync.WaitGroup
+	wg.Add(len(inputs))
+	for _, input := range inputs {
+		go func(input <-chan T) {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case item, ok := <-input:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case output <- item:
+					}
+				}
+			}
+		}(input)
+	}
+	go func() {
+		wg.Wait()
+		close(output)
+	}()
+	return output
+}
+
+// ChannelBatch returns a channel that receives items from the input channel
+// grouped into batches.
+//
+// A batch is sent when it reaches the given size or the timeout elapses since
+// the first item of the batch was received, whichever comes first. Empty batches
+// are never sent. The output channel is closed when the input channel is closed,
+// after sending any remaining partial batch, or when the context is cancelled.
+func ChannelBatch[T any](ctx context.Context, input <-chan T, size int, timeout time.Duration) <-chan []T {
+	output := make(chan []T)
+	go func() {
+		defer close(output)
+
+		var (
+			batch   []T
+			timer   *time.Timer
+			expired <-chan time.Time
+		)
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, expired = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			select {
+			case <-ctx.Done():
+				return false
+			case output <- batch:
+				batch = nil
+				return true
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-expired:
+				if !flush() {
+					return
+				}
+			case item, ok := <-input:
+				if !ok {
+					flush()
+					return
+				}
+				if timer == nil {
+					timer = time.NewTimer(timeout)
+					expired = timer.C
+				}
+				batch = append(batch, item)
+				if len(batch) >= size && !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return output
+}
+
+// ChannelMerge returns a channel that receives a Tuple2 for each pair of
+// items received from the two input channels.
+//
+// The output channel is closed when either input channel is closed or the
+// context is cancelled. An item received from one channel without a pair
+// from the other is dropped.
+func ChannelMerge[A any, B any](ctx context.Context, a <-chan A, b <-chan B) <-chan Tuple2[A, B] {
+	output := make(chan Tuple2[A, B])
+	go func() {
+		defer close(output)
+		for {
+			var pair Tuple2[A, B]
+			var ok bool
+
+			select {
+			case <-ctx.Done():
+				return
+			case pair.First, ok = <-a:
+				if !ok {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case pair.Second, ok = <-b:
+				if !ok {
+					return
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case output <- pair:
+			}
+		}
+	}()
+	return output
+}
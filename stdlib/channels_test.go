@@ -0,0 +1,305 @@
+package stdlib_test
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestSliceToChannel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ch := stdlib.SliceToChannel(context.Background(), []int{1, 2, 3})
+
+	var got []int
+	for item := range ch {
+		got = append(got, item)
+	}
+	test.Equal(got, []int{1, 2, 3})
+
+	_, ok := <-ch
+	test.False(ok, "channel must be closed")
+}
+
+func TestSliceToChannel_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	var got []int
+	for item := range stdlib.SliceToChannel(ctx, input) {
+		got = append(got, item)
+	}
+	test.True(slices.Equal(got, input[:len(got)]), "got %v, want a prefix of %v", got, input)
+}
+
+func TestChannelToSlice(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	got, err := stdlib.ChannelToSlice(context.Background(), stdlib.SliceToChannel(context.Background(), []int{1, 2, 3}))
+	test.OK(err)
+	test.Equal(got, []int{1, 2, 3})
+}
+
+func TestChannelToSlice_Closed(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ch := make(chan int)
+	close(ch)
+
+	got, err := stdlib.ChannelToSlice(context.Background(), ch)
+	test.OK(err)
+	test.Equal(got, []int(nil))
+}
+
+func TestChannelToSlice_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+
+	got, err := stdlib.ChannelToSlice(ctx, ch)
+	test.EqualError(err, context.Canceled)
+	test.Equal(got, []int{1, 2})
+}
+
+func TestChannelMap(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	input := stdlib.SliceToChannel(ctx, []int{1, 2, 3})
+	output := stdlib.ChannelMap(ctx, input, func(i int) string { return strconv.Itoa(i * 10) })
+
+	got, err := stdlib.ChannelToSlice(ctx, output)
+	test.OK(err)
+	test.Equal(got, []string{"10", "20", "30"})
+
+	_, ok := <-output
+	test.False(ok, "output must be closed when input is closed")
+}
+
+func TestChannelMap_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	input := make(chan int)
+	output := stdlib.ChannelMap(ctx, input, func(i int) int { return i })
+
+	cancel()
+	_, ok := <-output
+	test.False(ok, "output must be closed when the context is cancelled")
+}
+
+func TestChannelFilter(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	input := stdlib.SliceToChannel(ctx, []int{1, 2, 3, 4, 5, 6})
+	output := stdlib.ChannelFilter(ctx, input, func(i int) bool { return i%2 == 0 })
+
+	got, err := stdlib.ChannelToSlice(ctx, output)
+	test.OK(err)
+	test.Equal(got, []int{2, 4, 6})
+
+	_, ok := <-output
+	test.False(ok, "output must be closed when input is closed")
+}
+
+func TestChannelFilter_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	input := make(chan int)
+	output := stdlib.ChannelFilter(ctx, input, func(int) bool { return true })
+
+	cancel()
+	_, ok := <-output
+	test.False(ok, "output must be closed when the context is cancelled")
+}
+
+func TestChannelFanOut(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	outputs := stdlib.ChannelFanOut(ctx, stdlib.SliceToChannel(ctx, []int{1, 2, 3}), 3)
+	test.Equal(len(outputs), 3)
+
+	var wg sync.WaitGroup
+	got := make([][]int, len(outputs))
+	for i, output := range outputs {
+		wg.Add(1)
+		go func(i int, output <-chan int) {
+			defer wg.Done()
+			got[i], _ = stdlib.ChannelToSlice(ctx, output)
+		}(i, output)
+	}
+	wg.Wait()
+
+	test.Equal(got, [][]int{{1, 2, 3}, {1, 2, 3}, {1, 2, 3}})
+	for _, output := range outputs {
+		_, ok := <-output
+		test.False(ok, "output must be closed when input is closed")
+	}
+}
+
+func TestChannelFanOut_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	outputs := stdlib.ChannelFanOut(ctx, make(chan int), 2)
+
+	cancel()
+	for _, output := range outputs {
+		_, ok := <-output
+		test.False(ok, "output must be closed when the context is cancelled")
+	}
+}
+
+func TestChannelFanOut_Negative(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Panic(func() { stdlib.ChannelFanOut(context.Background(), make(chan int), -1) })
+}
+
+func TestChannelFanIn(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	output := stdlib.ChannelFanIn(ctx,
+		stdlib.SliceToChannel(ctx, []int{1, 2}),
+		stdlib.SliceToChannel(ctx, []int{3}),
+		stdlib.SliceToChannel(ctx, []int{4, 5, 6}),
+	)
+
+	got, err := stdlib.ChannelToSlice(ctx, output)
+	test.OK(err)
+	sort.Ints(got)
+	test.Equal(got, []int{1, 2, 3, 4, 5, 6})
+}
+
+func TestChannelFanIn_PartialClose(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	closed := make(chan int)
+	open := make(chan int)
+	output := stdlib.ChannelFanIn(ctx, closed, open)
+
+	close(closed)
+	open <- 1
+	test.Equal(<-output, 1)
+
+	close(open)
+	_, ok := <-output
+	test.False(ok, "output must be closed once all inputs are closed")
+}
+
+func TestChannelFanIn_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	output := stdlib.ChannelFanIn(ctx, make(chan int), make(chan int))
+
+	cancel()
+	_, ok := <-output
+	test.False(ok, "output must be closed when the context is cancelled")
+}
+
+func TestChannelBatch(t *testing.T) {
+	stdtest.Table[[]int, [][]int]{
+		"partial final batch": {
+			Got:  []int{1, 2, 3, 4, 5},
+			Want: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		"no empty final batch": {
+			Got:  []int{1, 2, 3, 4},
+			Want: [][]int{{1, 2}, {3, 4}},
+		},
+		"empty": {
+			Got:  []int{},
+			Want: nil,
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]int, [][]int]) {
+		ctx := context.Background()
+		output := stdlib.ChannelBatch(ctx, stdlib.SliceToChannel(ctx, tc.Got), 2, time.Hour)
+
+		got, err := stdlib.ChannelToSlice(ctx, output)
+		t.OK(err)
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestChannelBatch_Timeout(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	input := make(chan int)
+	defer close(input)
+	output := stdlib.ChannelBatch(ctx, input, 10, 50*time.Millisecond)
+
+	input <- 1
+	input <- 2
+	test.Equal(<-output, []int{1, 2})
+
+	input <- 3
+	test.Equal(<-output, []int{3})
+}
+
+func TestChannelBatch_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	input := make(chan int)
+	output := stdlib.ChannelBatch(ctx, input, 10, time.Hour)
+
+	input <- 1
+	cancel()
+	_, ok := <-output
+	test.False(ok, "output must be closed when the context is cancelled")
+}
+
+func TestChannelMerge(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx := context.Background()
+	output := stdlib.ChannelMerge(ctx,
+		stdlib.SliceToChannel(ctx, []int{1, 2, 3}),
+		stdlib.SliceToChannel(ctx, []string{"a", "b"}),
+	)
+
+	got, err := stdlib.ChannelToSlice(ctx, output)
+	test.OK(err)
+	test.Equal(got, []stdlib.Tuple2[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}})
+
+	_, ok := <-output
+	test.False(ok, "output must be closed when either input is closed")
+}
+
+func TestChannelMerge_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a := make(chan int)
+	output := stdlib.ChannelMerge(ctx, a, make(chan int))
+
+	a <- 1
+	cancel()
+	_, ok := <-output
+	test.False(ok, "output must be closed when the context is cancelled")
+}
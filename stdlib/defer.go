@@ -1,6 +1,9 @@
 package stdlib
 
-import "io"
+import (
+	"fmt"
+	"io"
+)
 
 // Defer is a helper for capturing errors from calls inside a 'defer'.
 func Defer(err *error, errs ...error) {
@@ -36,3 +39,44 @@ func DeferCloserToGroup(c **CloserGroup, closer ...io.Closer) io.Closer {
 	*c = CloserJoin(*c, closer...)
 	return *c
 }
+
+// RecoverInto returns a function for use in a 'defer' that recovers from a panic
+// and sets the given error pointer to the panic wrapped by the given Error.
+//
+// Example:
+//
+//	func run() (err error) {
+//		defer RecoverInto(ErrUndefined, &err)()
+//		...
+//	}
+func RecoverInto(e Error, out *error) func() {
+	return func() {
+		if r := recover(); r != nil {
+			*out = e.Wrap(panicError(r))
+		}
+	}
+}
+
+// Recovery returns a function for use in a 'defer' that recovers from a panic
+// and panics again with the original value wrapped by the Error.
+//
+// Use RecoverInto to return the wrapped panic as an error instead.
+//
+// Example:
+//
+//	defer ErrUndefined.Recovery()()
+func (e Error) Recovery() func() {
+	return func() {
+		if r := recover(); r != nil {
+			panic(e.Wrap(panicError(r)))
+		}
+	}
+}
+
+// panicError returns the given value recovered from a panic as an error.
+func panicError(r any) error {
+	if err, ok := r.(error); ok {
+		return err
+	}
+	return fmt.Errorf("panic: %v", r)
+}
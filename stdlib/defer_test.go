@@ -0,0 +1,65 @@
+package stdlib_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestRecoverInto(t *testing.T) {
+	errPanic := errors.New("boom")
+
+	stdtest.Table[any, string]{
+		"error value":  {Got: errPanic, Want: "boom"},
+		"string value": {Got: "boom", Want: "panic: boom"},
+		"int value":    {Got: 42, Want: "panic: 42"},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[any, string]) {
+		run := func() (err error) {
+			defer stdlib.RecoverInto(errA, &err)()
+			panic(tc.Got)
+		}
+
+		err := run()
+		var got stdlib.Error
+		t.True(errors.As(err, &got), "recovered error must be an Error: %#v", err)
+		t.True(got.Equal(errA), "got %v, want %v", got, errA)
+		t.Equal(errors.Unwrap(err).Error(), tc.Want)
+		if e, ok := tc.Got.(error); ok {
+			t.True(errors.Is(err, e), "recovered error must wrap the panic error")
+		}
+	})
+}
+
+func TestRecoverInto_NoPanic(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	run := func() (err error) {
+		defer stdlib.RecoverInto(errA, &err)()
+		return errB
+	}
+	test.Equal(run(), error(errB))
+}
+
+func TestError_Recovery(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	errPanic := errors.New("boom")
+	var recovered any
+	func() {
+		defer func() { recovered = recover() }()
+		defer errA.Recovery()()
+		panic(errPanic)
+	}()
+
+	got, ok := recovered.(stdlib.Error)
+	test.True(ok, "re-panic value must be an Error: %#v", recovered)
+	test.True(got.Equal(errA), "got %v, want %v", got, errA)
+	test.True(errors.Is(got, errPanic), "re-panic value must wrap the original panic")
+
+	test.True(func() (ok bool) {
+		defer errA.Recovery()()
+		return true
+	}(), "no panic must be a no-op")
+}
@@ -0,0 +1,153 @@
+package stdlib
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+var (
+	_ error                   = (*ConcurrentErrorGroup)(nil)
+	_ fmt.Formatter           = (*ConcurrentErrorGroup)(nil)
+	_ HasUnwrap               = (*ConcurrentErrorGroup)(nil)
+	_ KeyedRanger[int, Error] = (*ConcurrentErrorGroup)(nil)
+)
+
+// NewConcurrentErrorGroup creates a new *ConcurrentErrorGroup with sane defaults.
+func NewConcurrentErrorGroup(errs ...error) *ConcurrentErrorGroup {
+	return &ConcurrentErrorGroup{group: NewErrorGroup(errs...)}
+}
+
+// ConcurrentErrorGroup is an ErrorGroup that is safe for concurrent use,
+// e.g. appending errors from multiple goroutines.
+//
+// Read methods operate on a snapshot of the group taken under the lock, so
+// the functions given to them may safely call back into the group.
+type ConcurrentErrorGroup struct {
+	// group stores the errors; it must only be accessed while holding mu.
+	group *ErrorGroup
+	// mu protects concurrent access to group.
+	mu sync.RWMutex
+}
+
+// Append adds a new error to the group.
+func (g *ConcurrentErrorGroup) Append(errs ...error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.group.Append(errs...)
+}
+
+// Translate performs an in-place translation of errors
+// in the group for swapping context.
+func (g *ConcurrentErrorGroup) Translate(translate ErrorTranslate) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.group.Translate(translate)
+}
+
+// Swap moves errors in the group during sorting.
+//
+// Interface: sort.Interface.
+func (g *ConcurrentErrorGroup) Swap(i, j int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.group.Swap(i, j)
+}
+
+// Less determines order for sorting a group.
+//
+// Interface: sort.Interface.
+func (g *ConcurrentErrorGroup) Less(i, j int) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.group.Less(i, j)
+}
+
+// Len returns the number of errors in the group.
+//
+// Interface: sort.Interface.
+func (g *ConcurrentErrorGroup) Len() int {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.group.Len()
+}
+
+// Group returns a new *ErrorGroup containing a snapshot of the errors in the group.
+func (g *ConcurrentErrorGroup) Group() *ErrorGroup {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return &ErrorGroup{
+		Errors:    slices.Clone(g.group.Errors),
+		Formatter: g.group.Formatter,
+	}
+}
+
+// Error string value of the ConcurrentErrorGroup struct.
+//
+// Interface: error.
+func (g *ConcurrentErrorGroup) Error() string {
+	return g.Group().Error()
+}
+
+// Format returns a complex string representation of the ConcurrentErrorGroup
+// for the given verbs.
+//
+// Interface: fmt.Formatter.
+func (g *ConcurrentErrorGroup) Format(s fmt.State, verb rune) {
+	g.Group().Format(s, verb)
+}
+
+// Unwrap returns the next error in the group or nil if there are no more errors.
+//
+// Interface: errors.Unwrap, HasUnwrap.
+func (g *ConcurrentErrorGroup) Unwrap() error {
+	return g.Group().Unwrap()
+}
+
+// Range calls the given function for each error in the group along
+// with its index.
+//
+// If the function returns `false`, iteration will stop.
+//
+// Interface: KeyedRanger.
+func (g *ConcurrentErrorGroup) Range(predicate KeyedPredicate[int, Error]) {
+	g.Group().Range(predicate)
+}
+
+// Filter returns a new *ErrorGroup containing only the errors in the group
+// that match the predicate function.
+func (g *ConcurrentErrorGroup) Filter(predicate Predicate[Error]) *ErrorGroup {
+	return g.Group().Filter(predicate)
+}
+
+// Stats returns aggregate counts of the errors in the group.
+func (g *ConcurrentErrorGroup) Stats() ErrorGroupStats {
+	return g.Group().Stats()
+}
+
+// Slice returns a copy of all errors in the group.
+func (g *ConcurrentErrorGroup) Slice() []Error {
+	return g.Group().Errors
+}
+
+// Empty will return true if the group is empty.
+func (g *ConcurrentErrorGroup) Empty() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+
+	return g.group.Empty()
+}
+
+// ErrorOrNil returns this group as an error, or nil if it is empty.
+func (g *ConcurrentErrorGroup) ErrorOrNil() error {
+	if g.Empty() {
+		return nil
+	}
+	return g
+}
@@ -0,0 +1,71 @@
+package stdlib_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestConcurrentErrorGroup_Append(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	const goroutines, appends = 4, 50
+
+	g := stdlib.NewConcurrentErrorGroup()
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < appends; j++ {
+				g.Append(errA)
+			}
+		}()
+		// Readers race with the appends above when run with '-race'.
+		go func() {
+			defer wg.Done()
+			for j := 0; j < appends; j++ {
+				_ = fmt.Sprintf("%v", g)
+				_ = fmt.Sprintf("%+v", g)
+				_ = g.Error()
+				_ = g.Unwrap()
+				_ = g.Stats()
+				_ = g.Filter(func(stdlib.Error) bool { return true })
+				g.Range(func(int, stdlib.Error) bool { return true })
+			}
+		}()
+	}
+	wg.Wait()
+
+	test.Equal(g.Len(), goroutines*appends)
+	test.Equal(g.Stats().Total, goroutines*appends)
+}
+
+func TestConcurrentErrorGroup_RangeReentrant(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewConcurrentErrorGroup(errA, errB)
+	g.Range(func(_ int, err stdlib.Error) bool {
+		g.Append(err)
+		return true
+	})
+	test.Equal(g.Len(), 4)
+}
+
+func TestConcurrentErrorGroup_Snapshot(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewConcurrentErrorGroup(errA)
+	slice := g.Slice()
+	group := g.Group()
+	g.Append(errB)
+
+	test.Equal(len(slice), 1)
+	test.Equal(group.Len(), 1)
+	test.Equal(g.Len(), 2)
+	test.True(g.ErrorOrNil() != nil, "non-empty group must return an error")
+	test.True(stdlib.NewConcurrentErrorGroup().ErrorOrNil() == nil, "empty group must return nil")
+}
@@ -0,0 +1,8 @@
+//go:generate go-enum --marshal --names
+package stdlib
+
+// ErrorSeverity represents the severity level of an error which can be
+// used by log sinks and alerting pipelines for routing.
+//
+// ENUM(debug, info, warn, error, fatal).
+type ErrorSeverity string
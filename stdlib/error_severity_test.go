@@ -0,0 +1,33 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestParseErrorSeverity(t *testing.T) {
+	stdtest.Table[string, stdlib.ErrorSeverity]{
+		"debug": {Got: "debug", Want: stdlib.ErrorSeverityDebug},
+		"info":  {Got: "info", Want: stdlib.ErrorSeverityInfo},
+		"warn":  {Got: "warn", Want: stdlib.ErrorSeverityWarn},
+		"error": {Got: "error", Want: stdlib.ErrorSeverityError},
+		"fatal": {Got: "fatal", Want: stdlib.ErrorSeverityFatal},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[string, stdlib.ErrorSeverity]) {
+		got, err := stdlib.ParseErrorSeverity(tc.Got)
+		t.OK(err)
+		t.Equal(got, tc.Want)
+		t.True(got.IsValid(), "%q must be valid", got)
+	})
+}
+
+func TestParseErrorSeverity_Invalid(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	_, err := stdlib.ParseErrorSeverity("critical")
+	test.EqualError(err, stdlib.ErrInvalidErrorSeverity)
+
+	var zero stdlib.ErrorSeverity
+	test.False(zero.IsValid(), "zero value must not be valid")
+}
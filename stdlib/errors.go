@@ -1,10 +1,17 @@
 package stdlib
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"math"
+	"path"
 	"reflect"
+	"runtime"
 	"sort"
 	"strings"
 	"time"
@@ -19,8 +26,22 @@ const (
 	ErrorFlagRetryable
 	// ErrorFlagTimeout is set to represent errors indicating a timeout occurred.
 	ErrorFlagTimeout
+	// ErrorFlagPermanent is set to represent errors that should never be retried.
+	ErrorFlagPermanent
+	// ErrorFlagDeprecated is set to represent errors caused by use of a deprecated API.
+	ErrorFlagDeprecated
 )
 
+// ErrorFlagRegistry maps the well-known error flags to their names
+// for use with FormatBitmask.
+var ErrorFlagRegistry = map[Bitmask]string{
+	ErrorFlagUnknown:    "unknown",
+	ErrorFlagRetryable:  "retryable",
+	ErrorFlagTimeout:    "timeout",
+	ErrorFlagPermanent:  "permanent",
+	ErrorFlagDeprecated: "deprecated",
+}
+
 // ErrUndefined indicates the wrapped error is not well-known or previously
 // defined. This likely means it's coming from an external system/library and not
 // a domain error.
@@ -31,6 +52,13 @@ var ErrUndefined = Error{
 	Namespace: ErrorNamespaceDefault,
 }
 
+// ErrInvalidError indicates an Error is missing required fields.
+var ErrInvalidError = Error{
+	Code:      "invalid_error",
+	Message:   "error is missing required fields",
+	Namespace: ErrorNamespaceDefault,
+}
+
 // HasAs defines types necessary for stdlib `errors.As` support.
 type HasAs interface {
 	As(target any) bool
@@ -51,18 +79,116 @@ type Causer interface {
 	Cause() error
 }
 
+// ErrorCode is a machine-readable representation for an error.
+type ErrorCode string
+
+// String returns the ErrorCode string representation.
+//
+// Interface: fmt.Stringer.
+func (c ErrorCode) String() string {
+	return string(c)
+}
+
 // ErrorKey returns a slug that should be unique for each error (namespace + code).
-func ErrorKey(namespace, code string) string {
+func ErrorKey[C ~string](namespace string, code C) string {
 	return fmt.Sprintf("%s/%s", namespace, code)
 }
 
+// NewError creates a new Error with the given namespace, code and message.
+//
+// It panics if the Error is invalid, e.g. the namespace or code is empty.
+func NewError(namespace string, code ErrorCode, message string) Error {
+	return NewErrorWithFlags(namespace, code, message, 0)
+}
+
+// NewErrorWithFlags creates a new Error with the given namespace, code, message
+// and flags.
+//
+// It panics if the Error is invalid, e.g. the namespace or code is empty.
+func NewErrorWithFlags(namespace string, code ErrorCode, message string, flags Bitmask) Error {
+	e := Error{
+		Code:      code,
+		Flags:     flags,
+		Message:   message,
+		Namespace: namespace,
+	}
+	if err := e.Validate(); err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// DeprecateError returns a new copy of the Error flagged as deprecated, recording
+// the replacement error and the dates it was deprecated and will be sunset.
+func DeprecateError(e Error, replacement Error, since time.Time, sunset time.Time) Error {
+	return e.WithDeprecation(DeprecationExtras{
+		Replacement: replacement.Key(),
+		Since:       since,
+		Sunset:      sunset,
+	}).WithFlag(ErrorFlagDeprecated)
+}
+
+// IsDeprecated returns true if the given error is an Error that has been
+// flagged as deprecated.
+func IsDeprecated(err error) bool {
+	var e Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.IsDeprecated()
+}
+
+// errorChainMaxDepth is the maximum number of wrapped errors walked
+// when traversing a chain; it guards against cycles.
+const errorChainMaxDepth = 1000
+
+// Now returns the current time and is used when stamping errors.
+//
+// It can be replaced to provide a fixed clock.
+var Now = time.Now
+
+// StackTraceDepth is the maximum number of frames captured by CaptureStack.
+//
+// Setting it to zero disables stack trace capture.
+var StackTraceDepth = 32
+
+// CaptureStack returns a new copy of the Error with the stack trace
+// of the caller stored in its debug info.
+func CaptureStack(e Error) Error {
+	if StackTraceDepth <= 0 {
+		return e
+	}
+	// Skip runtime.Callers and CaptureStack.
+	pcs := make([]uintptr, StackTraceDepth)
+	n := runtime.Callers(2, pcs)
+	if n == 0 {
+		return e
+	}
+
+	var sb strings.Builder
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		sb.WriteString(fmt.Sprintf("%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line))
+		if !more {
+			break
+		}
+	}
+	return e.WithDebugInfo(DebugExtras{StackTrace: sb.String()})
+}
+
 // Error defines a standard application error primitive.
 //
 // TODO(ahawker) Add Format interface (for pretty strings)
 // TODO(ahawker) Namespace field? Embed in the code?
 type Error struct {
+	// Annotation contains ad-hoc key/value pairs added at each wrap site to
+	// trace execution flow. Use `Annotate` to add and `Annotations` to read them.
+	Annotation map[string]string `json:"annotations,omitempty"`
 	// Code is a machine-readable representation for the error.
-	Code string `json:"code"`
+	Code ErrorCode `json:"code"`
+	// CreatedAt is the time the error occurred, if stamped via `WithTimestamp` or `WithNow`.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Extras is an optional struct to store execution context
 	// that is helpful for understanding the error.
 	Extras ErrorExtras `json:"extras,omitempty"`
@@ -75,6 +201,9 @@ type Error struct {
 	// concept of errors. This is commonly used to indicate the package/repository/service
 	// an error originated from.
 	Namespace string `json:"namespace"`
+	// UserMessage is a human-readable representation for the error that is safe to
+	// show to end-users. The Message is used when it is not set.
+	UserMessage string `json:"user_message,omitempty"`
 	// Wrapped is a wrapped error if this was created from another via `Wrap`. This
 	// is hidden from human consumers and only visible to machine/operators.
 	Wrapped error `json:"-"`
@@ -85,100 +214,466 @@ func (e Error) Key() string {
 	return ErrorKey(e.Namespace, e.Code)
 }
 
+// Matches returns true if the Error key (namespace/code) matches the given
+// glob pattern, e.g. "namespace/*", "*/code" or "namespace/code".
+//
+// Patterns use 'path.Match' semantics; a malformed pattern never matches.
+func (e Error) Matches(pattern string) bool {
+	ok, err := path.Match(pattern, e.Key())
+	return err == nil && ok
+}
+
+// Validate returns an error if any required fields of the Error are not set.
+func (e Error) Validate() error {
+	switch {
+	case e.Namespace == "":
+		return ErrInvalidError.Wrapf("namespace is required")
+	case e.Code == "":
+		return ErrInvalidError.Wrapf("code is required")
+	default:
+		return nil
+	}
+}
+
+// IsCode returns true if the Error has the given namespace and code,
+// ignoring all other fields.
+func (e Error) IsCode(namespace string, code ErrorCode) bool {
+	return e.Namespace == namespace && e.Code == code
+}
+
+// MatchByCode returns true if this error, or any Error in its wrapped chain,
+// has the same namespace and code as the target.
+//
+// Unlike 'errors.Is', which uses Equal, this still matches errors that lost or
+// gained extras, e.g. after crossing a service boundary.
+func (e Error) MatchByCode(target Error) bool {
+	for _, err := range e.Flatten() {
+		if we, ok := err.(Error); ok && we.IsCode(target.Namespace, target.Code) {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeDelay returns the delay before the given retry attempt, starting
+// from zero, using the retry info of the Error.
+func (e Error) ComputeDelay(attempt int) time.Duration {
+	return e.Extras.Retry.ComputeDelay(attempt)
+}
+
 // Equal returns true if the two Error values are equal.
+//
+// Extras that only describe the context an error occurred in, e.g. the
+// operation, duration or component, are ignored so 'errors.Is' still matches
+// a sentinel error after they are added. Annotations and metadata are compared.
 func (e Error) Equal(e2 Error) bool {
-	return e.Code == e2.Code &&
+	return maps.Equal(e.Annotation, e2.Annotation) &&
+		e.Code == e2.Code &&
 		e.Message == e2.Message &&
 		e.Namespace == e2.Namespace &&
 		e.Flags == e2.Flags &&
 		reflect.DeepEqual(e.Extras.Debug, e2.Extras.Debug) &&
 		reflect.DeepEqual(e.Extras.Help, e2.Extras.Help) &&
+		maps.Equal(e.Extras.Metadata, e2.Extras.Metadata) &&
 		reflect.DeepEqual(e.Extras.Retry, e2.Extras.Retry)
 }
 
+// EqualIgnoreMessage returns true if the Error has the same code, namespace
+// and flags as the other, ignoring the message and all other fields.
+//
+// This is useful for versioned APIs where the message may change while the
+// error remains the same.
+func (e Error) EqualIgnoreMessage(other Error) bool {
+	return e.Code == other.Code &&
+		e.Namespace == other.Namespace &&
+		e.Flags == other.Flags
+}
+
+// Diff returns an ErrorDiff describing which fields differ between
+// this Error and the other.
+func (e Error) Diff(other Error) ErrorDiff {
+	return ErrorDiff{
+		AnnotationsChanged: !maps.Equal(e.Annotation, other.Annotation),
+		CodeChanged:        e.Code != other.Code,
+		CreatedAtChanged:   !e.CreatedAt.Equal(other.CreatedAt),
+		ExtrasChanged:      !reflect.DeepEqual(e.Extras, other.Extras),
+		FlagsChanged:       e.Flags != other.Flags,
+		MessageChanged:     e.Message != other.Message,
+		NamespaceChanged:   e.Namespace != other.Namespace,
+		UserMessageChanged: e.UserMessage != other.UserMessage,
+		WrappedChanged:     !reflect.DeepEqual(e.Wrapped, other.Wrapped),
+	}
+}
+
 // IsZero returns true if the Error is an empty/zero value.
 func (e Error) IsZero() bool {
-	return reflect.DeepEqual(e, new(Error))
+	return reflect.DeepEqual(e, Error{})
 }
 
 // IsRetryable returns true if the error indicates the failed operation
 // is safe to retry.
-func (e Error) IsRetryable() bool { return e.Flags.Has(ErrorFlagRetryable) }
+//
+// Permanent errors are never retryable.
+func (e Error) IsRetryable() bool {
+	return e.Flags.Has(ErrorFlagRetryable) && !e.IsPermanent()
+}
 
 // IsTimeout returns true if the error indicates an operation timeout.
 func (e Error) IsTimeout() bool { return e.Flags.Has(ErrorFlagTimeout) }
 
+// IsPermanent returns true if the error indicates the failed operation
+// should never be retried.
+func (e Error) IsPermanent() bool { return e.Flags.Has(ErrorFlagPermanent) }
+
+// IsDeprecated returns true if the error indicates use of a deprecated API.
+func (e Error) IsDeprecated() bool { return e.Flags.Has(ErrorFlagDeprecated) }
+
 // IsTransient returns true if the error indicates the operation failure
 // is transient and a result might be different if tried at another time.
-func (e Error) IsTransient() bool { return e.Flags.Has(ErrorFlagUnknown) }
+//
+// Permanent errors are never transient.
+func (e Error) IsTransient() bool {
+	return e.Flags.Has(ErrorFlagUnknown) && !e.IsPermanent()
+}
+
+// WithComponent returns a new copy of the Error with the given service component added.
+func (e Error) WithComponent(component string) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithComponent(component),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithDuration returns a new copy of the Error with the given operation duration added.
+func (e Error) WithDuration(d time.Duration) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithDuration(d),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithHTTPMethod returns a new copy of the Error with the given HTTP method added.
+func (e Error) WithHTTPMethod(method string) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithHTTPMethod(method),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithHTTPPath returns a new copy of the Error with the given HTTP path added.
+func (e Error) WithHTTPPath(p string) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithHTTPPath(p),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
 
 // WithFlag returns a new copy of the Error with the given attribute applied.
 func (e Error) WithFlag(attribute Bitmask) Error {
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras,
-		Flags:     e.Flags.Set(attribute),
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   e.Wrapped,
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras,
+		Flags:       e.Flags.Set(attribute),
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithCause returns a new copy of the Error with the given root cause added.
+//
+// The cause is stored independently of the wrapped error chain and
+// is returned by Cause, not Unwrap.
+func (e Error) WithCause(cause error) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithCause(cause),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
 	}
 }
 
 // WithDebugInfo returns a new copy of the Error with the given debug info added.
 func (e Error) WithDebugInfo(extras DebugExtras) Error {
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras.WithDebugExtras(extras),
-		Flags:     e.Flags,
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   e.Wrapped,
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithDebugExtras(extras),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithDeprecation returns a new copy of the Error with the given deprecation info added.
+func (e Error) WithDeprecation(extras DeprecationExtras) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithDeprecationExtras(extras),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
 	}
 }
 
 // WithHelp returns a new copy of the Error with the given help info added.
 func (e Error) WithHelp(extras HelpExtras) Error {
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras.WithHelpExtras(extras),
-		Flags:     e.Flags,
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   e.Wrapped,
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithHelpExtras(extras),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithField returns a new copy of the Error with the given field added
+// to its metadata, using the string representation of the value.
+func (e Error) WithField(key string, value any) Error {
+	return e.WithMetadata(key, MustString(value))
+}
+
+// WithMetadata returns a new copy of the Error with the given metadata key/value added.
+func (e Error) WithMetadata(key, value string) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithMetadata(key, value),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithOperation returns a new copy of the Error with the given operation added.
+func (e Error) WithOperation(op string) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithOperation(op),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithMaxAttempts returns a new copy of the Error with the given maximum retry attempts added.
+func (e Error) WithMaxAttempts(n int) Error {
+	extras := e.Extras.Retry
+	extras.MaxAttempts = n
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithRetryExtras(extras),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
 	}
 }
 
 // WithRetry returns a new copy of the Error with the given retry info added.
+//
+// If the given retry info does not set MaxAttempts, the existing value is kept.
 func (e Error) WithRetry(extras RetryExtras) Error {
+	if extras.MaxAttempts == 0 {
+		extras.MaxAttempts = e.Extras.Retry.MaxAttempts
+	}
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras.WithRetryExtras(extras),
-		Flags:     e.Flags,
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   e.Wrapped,
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithRetryExtras(extras),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithSeverity returns a new copy of the Error with the given severity level added.
+func (e Error) WithSeverity(severity ErrorSeverity) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithSeverity(severity),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
 	}
 }
 
 // WithTag returns a new copy of the Error with the given tags added.
 func (e Error) WithTag(tags ...string) Error {
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras.WithTag(tags...),
-		Flags:     e.Flags,
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   e.Wrapped,
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithTag(tags...),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// Annotate returns a new copy of the Error with the given annotation added.
+func (e Error) Annotate(key, value string) Error {
+	annotation := make(map[string]string, len(e.Annotation)+1)
+	maps.Copy(annotation, e.Annotation)
+	annotation[key] = value
+
+	return Error{
+		Annotation:  annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras,
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// Annotations returns a copy of the annotations added to this Error.
+//
+// Annotations of wrapped errors are not included; use `Flatten` to
+// access the annotations from each layer of wrapping.
+func (e Error) Annotations() map[string]string {
+	return maps.Clone(e.Annotation)
+}
+
+// WithTimestamp returns a new copy of the Error stamped with the given time.
+func (e Error) WithTimestamp(t time.Time) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   t,
+		Extras:      e.Extras,
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// WithNow returns a new copy of the Error stamped with the current time.
+func (e Error) WithNow() Error {
+	return e.WithTimestamp(Now())
+}
+
+// Age returns the duration since the error was stamped via `WithTimestamp`.
+//
+// If the error has no timestamp, zero is returned.
+func (e Error) Age() time.Duration {
+	if e.CreatedAt.IsZero() {
+		return 0
+	}
+	return Now().Sub(e.CreatedAt)
+}
+
+// IsExpired returns true if the error was stamped via `WithTimestamp`
+// more than the given ttl ago.
+//
+// An error without a timestamp never expires.
+func (e Error) IsExpired(ttl time.Duration) bool {
+	return !e.CreatedAt.IsZero() && e.Age() > ttl
+}
+
+// WithUserMessage returns a new copy of the Error with the given end-user message added.
+func (e Error) WithUserMessage(msg string) Error {
+	return Error{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras,
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: msg,
+		Wrapped:     e.Wrapped,
 	}
 }
 
 // AsGroup returns a *ErrorGroup containing this error and all
 // wrapped errors it contains.
+//
+// If a wrapped error is an *ErrorGroup, the chains of all of its
+// errors are included.
 func (e Error) AsGroup() *ErrorGroup {
 	g := NewErrorGroup(e)
 
 	err := e
 	for err.Wrapped != nil {
+		if eg, ok := err.Wrapped.(*ErrorGroup); ok {
+			for _, member := range eg.Errors {
+				g.Append(member.AsGroup())
+			}
+			break
+		}
+
 		g.Append(err.Wrapped)
 
 		var we Error
@@ -191,6 +686,27 @@ func (e Error) AsGroup() *ErrorGroup {
 	return g
 }
 
+// Flatten returns this error and all errors in its wrapped chain,
+// ordered from outermost to innermost.
+//
+// Unlike AsGroup, the chain is walked with 'errors.Unwrap' and every
+// error is included regardless of type.
+func (e Error) Flatten() []error {
+	output := []error{e}
+	for err := errors.Unwrap(e); err != nil && len(output) <= errorChainMaxDepth; err = errors.Unwrap(err) {
+		output = append(output, err)
+	}
+	return output
+}
+
+// Depth returns the number of errors wrapped by this error, following
+// 'errors.Unwrap' through errors of any type.
+//
+// An error that wraps nothing has a depth of zero.
+func (e Error) Depth() int {
+	return len(e.Flatten()) - 1
+}
+
 // String returns the Error string representation.
 //
 // Interface: fmt.Stringer.
@@ -229,13 +745,28 @@ func (e Error) Format(s fmt.State, verb rune) {
 func (e Error) Error() string {
 	var sb strings.Builder
 
-	sb.WriteString(fmt.Sprintf("[%s:%s] %s", e.Namespace, e.Code, e.Message))
+	sb.WriteString(fmt.Sprintf("[%s:%s] ", e.Namespace, e.Code))
+	if e.Extras.Operation != "" {
+		sb.WriteString(fmt.Sprintf("%s: ", e.Extras.Operation))
+	}
+	sb.WriteString(e.Message)
 	if e.Wrapped != nil {
 		sb.WriteString(fmt.Sprintf("\n-> %s", e.Wrapped.Error()))
 	}
 	return sb.String()
 }
 
+// UserError returns the string representation of the Error that is safe
+// to show to end-users.
+//
+// The UserMessage is returned if set, otherwise the Message.
+func (e Error) UserError() string {
+	if e.UserMessage != "" {
+		return e.UserMessage
+	}
+	return e.Message
+}
+
 // Is implements error equality checking.
 //
 // Interface: HasIs.
@@ -247,6 +778,21 @@ func (e Error) Is(target error) bool {
 	return e.Equal(err)
 }
 
+// Cause returns the root cause of the error.
+//
+// The cause set by WithCause is returned if present, otherwise the innermost
+// error of the wrapped chain, walked with 'errors.Unwrap'. An error that wraps
+// nothing is its own cause.
+//
+// Interface: Causer.
+func (e Error) Cause() error {
+	if e.Extras.Cause != nil {
+		return e.Extras.Cause
+	}
+	chain := e.Flatten()
+	return chain[len(chain)-1]
+}
+
 // Unwrap implements error unwrapping for nested errors.
 //
 // Interface: Unwrap.
@@ -260,6 +806,9 @@ func (e Error) Unwrap() error {
 // is a zero value, just return a copy of the given Error. This
 // allows us to avoid checking this case at every call-site; we
 // can just Wrap the error and handle it.
+//
+// If the given err is an *ErrorGroup, it is stored as-is. 'errors.Is'
+// and 'errors.As' will then match against any error in the group.
 func (e Error) Wrap(err error) Error {
 	if err == nil {
 		return e
@@ -271,12 +820,15 @@ func (e Error) Wrap(err error) Error {
 		}
 	}
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras,
-		Flags:     e.Flags,
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   err,
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras,
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     err,
 	}
 }
 
@@ -293,93 +845,566 @@ func (e Error) Copy() Error {
 
 		if errors.As(e.Wrapped, &wrapped) {
 			return Error{
-				Code:      e.Code,
-				Extras:    e.Extras,
-				Flags:     e.Flags,
-				Message:   e.Message,
-				Namespace: e.Namespace,
-				Wrapped:   wrapped.Copy(),
+				Annotation:  maps.Clone(e.Annotation),
+				Code:        e.Code,
+				CreatedAt:   e.CreatedAt,
+				Extras:      e.Extras,
+				Flags:       e.Flags,
+				Message:     e.Message,
+				Namespace:   e.Namespace,
+				UserMessage: e.UserMessage,
+				Wrapped:     wrapped.Copy(),
 			}
 		}
 	}
 	return Error{
-		Code:      e.Code,
-		Extras:    e.Extras,
-		Flags:     e.Flags,
-		Message:   e.Message,
-		Namespace: e.Namespace,
-		Wrapped:   e.Wrapped,
+		Annotation:  maps.Clone(e.Annotation),
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras,
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+		Wrapped:     e.Wrapped,
+	}
+}
+
+// jsonMarshal encodes errors as JSON for AsJSON; it is replaced by tests.
+var jsonMarshal = json.Marshal
+
+// AsJSON returns the JSON encoding of the Error.
+func (e Error) AsJSON() ([]byte, error) {
+	return jsonMarshal(e)
+}
+
+// HideWrappedJSON omits the chain of wrapped errors when an Error is
+// encoded as JSON, e.g. to hide internal details from external consumers.
+var HideWrappedJSON = false
+
+// errorJSONPlain is an Error without its JSON methods.
+type errorJSONPlain Error
+
+// errorJSON is the JSON representation of an Error.
+type errorJSON struct {
+	errorJSONPlain
+	CreatedAt *time.Time       `json:"created_at,omitempty"`
+	Wrapped   *json.RawMessage `json:"wrapped,omitempty"`
+}
+
+// messageJSON is the JSON representation of a wrapped error that is not an Error.
+type messageJSON struct {
+	Message string           `json:"message"`
+	Wrapped *json.RawMessage `json:"wrapped,omitempty"`
+}
+
+// messageError is a decoded wrapped error that was not an Error. It retains
+// the message and the rest of the wrapped chain.
+type messageError struct {
+	msg     string
+	wrapped error
+}
+
+// Error implements the error interface.
+func (e *messageError) Error() string {
+	return e.msg
+}
+
+// Unwrap implements errors.Unwrap by returning the wrapped error.
+func (e *messageError) Unwrap() error {
+	return e.wrapped
+}
+
+// MarshalJSON returns the JSON encoding of the Error.
+//
+// The chain of wrapped errors, walked with 'errors.Unwrap', is rendered as
+// nested 'wrapped' objects unless HideWrappedJSON is set. Errors in the chain
+// that are not an Error only retain their message, while any Error beneath
+// them is still encoded in full. The creation time is omitted when it is not set.
+//
+// Interface: json.Marshaler.
+func (e Error) MarshalJSON() ([]byte, error) {
+	ej := errorJSON{errorJSONPlain: errorJSONPlain(e)}
+	if !e.CreatedAt.IsZero() {
+		ej.CreatedAt = &e.CreatedAt
+	}
+	if e.Wrapped != nil && !HideWrappedJSON {
+		wrapped, err := marshalWrappedJSON(e.Wrapped)
+		if err != nil {
+			return nil, err
+		}
+		ej.Wrapped = wrapped
+	}
+	return json.Marshal(ej)
+}
+
+// marshalWrappedJSON returns the JSON encoding of the given wrapped error chain.
+func marshalWrappedJSON(err error) (*json.RawMessage, error) {
+	// Collect the errors that are not an Error until the chain ends or
+	// reaches an Error, which encodes the rest of the chain itself.
+	var plain []error
+	for ; err != nil && len(plain) <= errorChainMaxDepth; err = errors.Unwrap(err) {
+		if _, ok := err.(Error); ok {
+			break
+		}
+		plain = append(plain, err)
+	}
+
+	var wrapped *json.RawMessage
+	if we, ok := err.(Error); ok {
+		b, err := json.Marshal(we)
+		if err != nil {
+			return nil, err
+		}
+		wrapped = (*json.RawMessage)(&b)
+	}
+	for i := len(plain) - 1; i >= 0; i-- {
+		b, err := json.Marshal(messageJSON{Message: plain[i].Error(), Wrapped: wrapped})
+		if err != nil {
+			return nil, err
+		}
+		wrapped = (*json.RawMessage)(&b)
+	}
+	return wrapped, nil
+}
+
+// UnmarshalJSON sets the Error from its JSON encoding.
+//
+// Wrapped errors that are not an Error are restored with their message and
+// the rest of the wrapped chain, so 'errors.Is' still matches any Error in it.
+//
+// Interface: json.Unmarshaler.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var ej errorJSON
+	if err := json.Unmarshal(data, &ej); err != nil {
+		return err
+	}
+	*e = Error(ej.errorJSONPlain)
+	if ej.CreatedAt != nil {
+		e.CreatedAt = *ej.CreatedAt
+	}
+	if ej.Wrapped != nil {
+		var wrapped Error
+		if err := json.Unmarshal(*ej.Wrapped, &wrapped); err != nil {
+			return err
+		}
+		switch {
+		case wrapped.Code != "" || wrapped.Namespace != "":
+			e.Wrapped = wrapped
+		case wrapped.Wrapped != nil:
+			e.Wrapped = &messageError{msg: wrapped.Message, wrapped: wrapped.Wrapped}
+		default:
+			e.Wrapped = errors.New(wrapped.Message)
+		}
+	}
+	return nil
+}
+
+// AsJSONString returns the JSON encoding of the Error as a string
+// and panics if it cannot.
+func (e Error) AsJSONString() string {
+	b, err := e.AsJSON()
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// errorGob is the encoding/gob representation of an Error.
+type errorGob struct {
+	Annotation  map[string]string
+	Code        ErrorCode
+	CreatedAt   time.Time
+	Extras      ErrorExtras
+	Flags       Bitmask
+	Message     string
+	Namespace   string
+	UserMessage string
+	Wrapped     *Error
+}
+
+// GobEncode returns the encoding/gob representation of the Error.
+//
+// The wrapped error is encoded as the first Error found in its chain with
+// 'errors.As'. Any wrapping layers above it that are not an Error, e.g. from
+// 'fmt.Errorf', are dropped, as is a chain with no Error. The cause is also dropped.
+//
+// Interface: gob.GobEncoder.
+func (e Error) GobEncode() ([]byte, error) {
+	eg := errorGob{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras.WithCause(nil),
+		Flags:       e.Flags,
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+	}
+	var wrapped Error
+	if e.Wrapped != nil && errors.As(e.Wrapped, &wrapped) {
+		eg.Wrapped = &wrapped
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(eg); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode sets the Error from its encoding/gob representation.
+//
+// Interface: gob.GobDecoder.
+func (e *Error) GobDecode(data []byte) error {
+	var eg errorGob
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&eg); err != nil {
+		return err
+	}
+	*e = Error{
+		Annotation:  eg.Annotation,
+		Code:        eg.Code,
+		CreatedAt:   eg.CreatedAt,
+		Extras:      eg.Extras,
+		Flags:       eg.Flags,
+		Message:     eg.Message,
+		Namespace:   eg.Namespace,
+		UserMessage: eg.UserMessage,
 	}
+	if eg.Wrapped != nil {
+		e.Wrapped = *eg.Wrapped
+	}
+	return nil
 }
 
 var (
+	_ Zeroer = (*ErrorDiff)(nil)
 	_ Zeroer = (*ErrorExtras)(nil)
 	_ Zeroer = (*DebugExtras)(nil)
+	_ Zeroer = (*DeprecationExtras)(nil)
 	_ Zeroer = (*HelpExtras)(nil)
 	_ Zeroer = (*RetryExtras)(nil)
 )
 
+// ErrorDiff describes which fields differ between two Error values.
+type ErrorDiff struct {
+	// AnnotationsChanged is true if the annotations differ.
+	AnnotationsChanged bool
+	// CodeChanged is true if the codes differ.
+	CodeChanged bool
+	// CreatedAtChanged is true if the creation times differ.
+	CreatedAtChanged bool
+	// ExtrasChanged is true if the extras differ.
+	ExtrasChanged bool
+	// FlagsChanged is true if the flags differ.
+	FlagsChanged bool
+	// MessageChanged is true if the messages differ.
+	MessageChanged bool
+	// NamespaceChanged is true if the namespaces differ.
+	NamespaceChanged bool
+	// UserMessageChanged is true if the end-user messages differ.
+	UserMessageChanged bool
+	// WrappedChanged is true if the wrapped errors differ.
+	WrappedChanged bool
+}
+
+// IsZero returns true if no fields differ, i.e. the errors are identical.
+func (d ErrorDiff) IsZero() bool {
+	return d == ErrorDiff{}
+}
+
 // ErrorExtras contains common additional info attached to errors.
 type ErrorExtras struct {
+	// Cause is the root cause of the error, independent of the wrapped chain.
+	Cause error `json:"-" yaml:"-"`
+	// Component of the service that produced the error, e.g. "database".
+	Component string `json:"component,omitempty" yaml:"component,omitempty"`
 	// Debug information captured from the error.
-	Debug DebugExtras `json:"debug,omitempty"`
+	Debug DebugExtras `json:"debug,omitempty" yaml:"debug,omitempty"`
+	// Deprecation information for errors that are being phased out.
+	Deprecation DeprecationExtras `json:"deprecation,omitempty" yaml:"deprecation,omitempty"`
+	// Duration of the failed operation before the error occurred.
+	Duration time.Duration `json:"duration,omitempty" yaml:"duration,omitempty"`
+	// HTTPMethod of the request that failed, e.g. "GET".
+	HTTPMethod string `json:"http_method,omitempty" yaml:"http_method,omitempty"`
+	// HTTPPath of the request that failed, e.g. "/users/1".
+	HTTPPath string `json:"http_path,omitempty" yaml:"http_path,omitempty"`
 	// Help information to inform operators about the error.
-	Help HelpExtras `json:"help,omitempty"`
+	Help HelpExtras `json:"help,omitempty" yaml:"help,omitempty"`
+	// Metadata contains arbitrary key/value context, e.g. request ID.
+	Metadata map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	// Operation that failed, e.g. "database.query".
+	Operation string `json:"operation,omitempty" yaml:"operation,omitempty"`
 	// Retry information regarding the failed operation.
-	Retry RetryExtras `json:"retry,omitempty"`
+	Retry RetryExtras `json:"retry,omitempty" yaml:"retry,omitempty"`
+	// Severity level of the error, e.g. "warn".
+	Severity ErrorSeverity `json:"severity,omitempty" yaml:"severity,omitempty"`
 	// Tags are additional labels that can be used to categorize errors.
-	Tags []string `json:"tags,omitempty"`
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+}
+
+// WithCause returns a new copy of the ErrorExtras with the given cause set.
+func (e ErrorExtras) WithCause(cause error) ErrorExtras {
+	return ErrorExtras{
+		Cause:       cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithComponent returns a new copy of the ErrorExtras with the given component set.
+func (e ErrorExtras) WithComponent(component string) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
 }
 
 // WithDebugExtras returns a new copy of the ErrorExtras with the given debug info set.
 func (e ErrorExtras) WithDebugExtras(extras DebugExtras) ErrorExtras {
 	return ErrorExtras{
-		Debug: extras,
-		Help:  e.Help,
-		Retry: e.Retry,
-		Tags:  e.Tags,
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       extras,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithDeprecationExtras returns a new copy of the ErrorExtras with the given deprecation info set.
+func (e ErrorExtras) WithDeprecationExtras(extras DeprecationExtras) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: extras,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithDuration returns a new copy of the ErrorExtras with the given duration set.
+func (e ErrorExtras) WithDuration(d time.Duration) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    d,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithHTTPMethod returns a new copy of the ErrorExtras with the given HTTP method set.
+func (e ErrorExtras) WithHTTPMethod(method string) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  method,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithHTTPPath returns a new copy of the ErrorExtras with the given HTTP path set.
+func (e ErrorExtras) WithHTTPPath(p string) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    p,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
 	}
 }
 
 // WithHelpExtras returns a new copy of the ErrorExtras with the given help info set.
 func (e ErrorExtras) WithHelpExtras(extras HelpExtras) ErrorExtras {
 	return ErrorExtras{
-		Debug: e.Debug,
-		Help:  extras,
-		Retry: e.Retry,
-		Tags:  e.Tags,
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        extras,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithMetadata returns a new copy of the ErrorExtras with the given metadata key/value set.
+func (e ErrorExtras) WithMetadata(key, value string) ErrorExtras {
+	metadata := maps.Clone(e.Metadata)
+	if metadata == nil {
+		metadata = make(map[string]string, 1)
+	}
+	metadata[key] = value
+
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithOperation returns a new copy of the ErrorExtras with the given operation set.
+func (e ErrorExtras) WithOperation(op string) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   op,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
 	}
 }
 
 // WithRetryExtras returns a new copy of the ErrorExtras with the given retry info set.
 func (e ErrorExtras) WithRetryExtras(extras RetryExtras) ErrorExtras {
 	return ErrorExtras{
-		Debug: e.Debug,
-		Help:  e.Help,
-		Retry: extras,
-		Tags:  e.Tags,
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       extras,
+		Severity:    e.Severity,
+		Tags:        e.Tags,
+	}
+}
+
+// WithSeverity returns a new copy of the ErrorExtras with the given severity set.
+func (e ErrorExtras) WithSeverity(severity ErrorSeverity) ErrorExtras {
+	return ErrorExtras{
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    severity,
+		Tags:        e.Tags,
 	}
 }
 
 // WithTag returns a new copy of the ErrorExtras with the given tags set.
 func (e ErrorExtras) WithTag(tags ...string) ErrorExtras {
 	return ErrorExtras{
-		Debug: e.Debug,
-		Help:  e.Help,
-		Retry: e.Retry,
-		Tags:  append(e.Tags, tags...),
+		Cause:       e.Cause,
+		Component:   e.Component,
+		Debug:       e.Debug,
+		Deprecation: e.Deprecation,
+		Duration:    e.Duration,
+		HTTPMethod:  e.HTTPMethod,
+		HTTPPath:    e.HTTPPath,
+		Help:        e.Help,
+		Metadata:    e.Metadata,
+		Operation:   e.Operation,
+		Retry:       e.Retry,
+		Severity:    e.Severity,
+		Tags:        append(e.Tags, tags...),
 	}
 }
 
 // IsZero returns true if the ErrorExtras object is the zero/empty struct value.
 func (e ErrorExtras) IsZero() bool {
-	return e.Debug.IsZero() && e.Help.IsZero() && e.Retry.IsZero() && len(e.Tags) == 0
+	return e.Cause == nil && e.Component == "" && e.Debug.IsZero() && e.Deprecation.IsZero() && e.Duration == 0 && e.HTTPMethod == "" && e.HTTPPath == "" && e.Help.IsZero() && len(e.Metadata) == 0 && e.Operation == "" && e.Retry.IsZero() && e.Severity == "" && len(e.Tags) == 0
 }
 
 // DebugExtras contains helpful information for debugging the error.
 type DebugExtras struct {
 	// StackTrace of the error.
-	StackTrace string `json:"stack_trace,omitempty"`
+	StackTrace string `json:"stack_trace,omitempty" yaml:"stack_trace,omitempty"`
 }
 
 // IsZero returns true if the Extras object is the zero/empty struct value.
@@ -387,6 +1412,47 @@ func (e DebugExtras) IsZero() bool {
 	return e.StackTrace == ""
 }
 
+// DeprecationExtras contains information about an error that is being phased out.
+type DeprecationExtras struct {
+	// Replacement is the key (namespace/code) of the error that should be used instead.
+	Replacement string `json:"replacement,omitempty" yaml:"replacement,omitempty"`
+	// Since is the time the error was deprecated.
+	Since time.Time `json:"since,omitempty" yaml:"since,omitempty"`
+	// Sunset is the time after which the error will no longer be returned.
+	Sunset time.Time `json:"sunset,omitempty" yaml:"sunset,omitempty"`
+}
+
+// IsZero returns true if the Extras object is the zero/empty struct value.
+func (e DeprecationExtras) IsZero() bool {
+	return e.Replacement == "" && e.Since.IsZero() && e.Sunset.IsZero()
+}
+
+// deprecationJSONPlain is a DeprecationExtras without its JSON methods.
+type deprecationJSONPlain DeprecationExtras
+
+// deprecationJSON is the JSON representation of a DeprecationExtras.
+type deprecationJSON struct {
+	deprecationJSONPlain
+	Since  *time.Time `json:"since,omitempty"`
+	Sunset *time.Time `json:"sunset,omitempty"`
+}
+
+// MarshalJSON returns the JSON encoding of the DeprecationExtras.
+//
+// The since and sunset times are omitted when they are not set.
+//
+// Interface: json.Marshaler.
+func (e DeprecationExtras) MarshalJSON() ([]byte, error) {
+	dj := deprecationJSON{deprecationJSONPlain: deprecationJSONPlain(e)}
+	if !e.Since.IsZero() {
+		dj.Since = &e.Since
+	}
+	if !e.Sunset.IsZero() {
+		dj.Sunset = &e.Sunset
+	}
+	return json.Marshal(dj)
+}
+
 // Link contains a description and hyperlink.
 type Link struct {
 	URL         string
@@ -396,7 +1462,7 @@ type Link struct {
 // HelpExtras contains helpful hyperlinks for the error.
 type HelpExtras struct {
 	// Links to help documentation regarding the error.
-	Links []Link `json:"links,omitempty"`
+	Links []Link `json:"links,omitempty" yaml:"links,omitempty"`
 }
 
 // IsZero returns true if the Extras object is the zero/empty struct value.
@@ -408,11 +1474,51 @@ func (e HelpExtras) IsZero() bool {
 type RetryExtras struct {
 	// Delay duration abide by before retrying the failed operation.
 	Delay time.Duration
+	// MaxAttempts is the maximum number of attempts, including the first,
+	// before giving up on the failed operation.
+	MaxAttempts int `json:",omitempty" yaml:",omitempty"`
+	// Backoff is the strategy used to grow the delay between attempts.
+	Backoff BackoffStrategy `json:",omitempty" yaml:",omitempty"`
+	// MaxDelay caps the delay computed for any attempt; zero means no cap.
+	MaxDelay time.Duration `json:",omitempty" yaml:",omitempty"`
 }
 
 // IsZero returns true if the Extras object is the zero/empty struct value.
 func (e RetryExtras) IsZero() bool {
-	return e.Delay == 0
+	return e.Delay == 0 && e.MaxAttempts == 0 && e.Backoff == "" && e.MaxDelay == 0
+}
+
+// ComputeDelay returns the delay before the given retry attempt, starting
+// from zero, using the backoff strategy.
+//
+// constant: Delay
+// linear: Delay * (attempt + 1)
+// exponential: Delay * 2^attempt
+//
+// An unset strategy is treated as constant. The result is capped at MaxDelay, if set.
+func (e RetryExtras) ComputeDelay(attempt int) time.Duration {
+	attempt = max(attempt, 0)
+
+	var delay time.Duration
+	switch e.Backoff {
+	case BackoffStrategyLinear:
+		delay = e.Delay * time.Duration(attempt+1)
+		if attempt > 0 && delay/time.Duration(attempt+1) != e.Delay {
+			delay = math.MaxInt64
+		}
+	case BackoffStrategyExponential:
+		delay = e.Delay << attempt
+		if e.Delay != 0 && (attempt >= 63 || delay>>attempt != e.Delay) {
+			delay = math.MaxInt64
+		}
+	default:
+		delay = e.Delay
+	}
+
+	if e.MaxDelay > 0 {
+		delay = min(delay, e.MaxDelay)
+	}
+	return delay
 }
 
 var (
@@ -485,9 +1591,11 @@ func ErrorGroupFormatterDefault(errors []Error) string {
 }
 
 var (
-	_ error          = (*ErrorGroup)(nil)
-	_ HasUnwrap      = (*ErrorGroup)(nil)
-	_ sort.Interface = (*ErrorGroup)(nil)
+	_ error                   = (*ErrorGroup)(nil)
+	_ fmt.Formatter           = (*ErrorGroup)(nil)
+	_ HasUnwrap               = (*ErrorGroup)(nil)
+	_ KeyedRanger[int, Error] = (*ErrorGroup)(nil)
+	_ sort.Interface          = (*ErrorGroup)(nil)
 )
 
 // NewErrorGroup creates a new *ErrorGroup with sane defaults.
@@ -530,8 +1638,9 @@ func (g *ErrorGroup) Append(errs ...error) {
 
 		// When given an error that's a group, we want to flatten & merge
 		// the items.
+		// Note: An Error that wraps a group is appended as-is.
 		var eg *ErrorGroup
-		if errors.As(err, &eg) {
+		if _, ok := err.(Error); !ok && errors.As(err, &eg) {
 			g.Append(SliceTypeAssert[Error, error](eg.Errors)...)
 			continue
 		}
@@ -555,6 +1664,288 @@ func (g *ErrorGroup) Slice() []Error {
 	return g.Errors
 }
 
+// Range calls the given function for each error in the group along
+// with its index.
+//
+// Errors are passed by value, so modifying them within the function does not
+// affect the group. If the function returns `false`, iteration will stop.
+//
+// Interface: KeyedRanger.
+func (g *ErrorGroup) Range(predicate KeyedPredicate[int, Error]) {
+	if g == nil {
+		return
+	}
+	for i, err := range g.Errors {
+		if !predicate(i, err) {
+			return
+		}
+	}
+}
+
+// ErrorGroupStats contains aggregate counts of the errors in a group.
+type ErrorGroupStats struct {
+	// Total number of errors in the group.
+	Total int
+	// ByFlag counts errors by each single flag bit they have set.
+	ByFlag map[uint64]int
+	// ByNamespace counts errors by namespace.
+	ByNamespace map[string]int
+	// ByCode counts errors by code, regardless of namespace.
+	ByCode map[string]int
+}
+
+// Stats returns aggregate counts of the errors in the group.
+func (g *ErrorGroup) Stats() ErrorGroupStats {
+	stats := ErrorGroupStats{
+		ByFlag:      make(map[uint64]int),
+		ByNamespace: make(map[string]int),
+		ByCode:      make(map[string]int),
+	}
+	g.Range(func(_ int, err Error) bool {
+		stats.Total++
+		stats.ByNamespace[err.Namespace]++
+		stats.ByCode[err.Code.String()]++
+		for bit := Bitmask(1); bit != 0; bit <<= 1 {
+			if err.Flags.Has(bit) {
+				stats.ByFlag[uint64(bit)]++
+			}
+		}
+		return true
+	})
+	return stats
+}
+
+// ByComponent returns the errors in the group partitioned into
+// new groups by their component.
+//
+// Errors without a component are excluded. Each group keeps this
+// group's Formatter.
+func (g *ErrorGroup) ByComponent() map[string]*ErrorGroup {
+	output := make(map[string]*ErrorGroup)
+	g.Range(func(_ int, err Error) bool {
+		if err.Extras.Component == "" {
+			return true
+		}
+		eg, ok := output[err.Extras.Component]
+		if !ok {
+			eg = NewErrorGroup()
+			if g.Formatter != nil {
+				eg.Formatter = g.Formatter
+			}
+			output[err.Extras.Component] = eg
+		}
+		eg.Errors = append(eg.Errors, err)
+		return true
+	})
+	return output
+}
+
+// ErrorsAs returns all errors in the group for which 'errors.As'
+// with the given target returns true.
+//
+// The target is set to the value of the last matching error.
+func (g *ErrorGroup) ErrorsAs(target any) []Error {
+	if g == nil {
+		return nil
+	}
+	var output []Error
+	for _, err := range g.Errors {
+		if errors.As(err, target) {
+			output = append(output, err)
+		}
+	}
+	return output
+}
+
+// Flatten returns a new *ErrorGroup where errors that wrap a nested
+// *ErrorGroup, at any depth, are recursively replaced by its errors.
+//
+// Each error taken from a nested group is wrapped by the errors that wrapped
+// the group, so no context is lost. Errors that wrap an empty group are kept
+// without it.
+func (g *ErrorGroup) Flatten() *ErrorGroup {
+	eg := NewErrorGroup()
+	if g == nil {
+		return eg
+	}
+	if g.Formatter != nil {
+		eg.Formatter = g.Formatter
+	}
+	for _, err := range g.Errors {
+		flattened, _ := flattenErrorGroups(err)
+		eg.Errors = append(eg.Errors, flattened...)
+	}
+	return eg
+}
+
+// flattenErrorGroups returns the error with any *ErrorGroup in its wrapped
+// chain replaced by one error per group member, and whether it was changed.
+func flattenErrorGroups(e Error) ([]Error, bool) {
+	var members []Error
+	switch wrapped := e.Wrapped.(type) {
+	case *ErrorGroup:
+		members = wrapped.Flatten().Errors
+		if len(members) == 0 {
+			e.Wrapped = nil
+			return []Error{e}, true
+		}
+	case Error:
+		var changed bool
+		if members, changed = flattenErrorGroups(wrapped); !changed {
+			return []Error{e}, false
+		}
+	default:
+		return []Error{e}, false
+	}
+	return SliceMap(members, func(member Error) Error {
+		return e.Wrap(member)
+	}), true
+}
+
+// Deduplicate returns a new *ErrorGroup with at most one error per key
+// (namespace/code), keeping the first occurrence.
+//
+// Errors wrapped with ErrUndefined share a key, so they are also
+// distinguished by the message of the error they wrap.
+func (g *ErrorGroup) Deduplicate() *ErrorGroup {
+	return g.DeduplicateBy(func(err Error) string {
+		if err.IsCode(ErrUndefined.Namespace, ErrUndefined.Code) && err.Wrapped != nil {
+			return err.Key() + ": " + err.Wrapped.Error()
+		}
+		return err.Key()
+	})
+}
+
+// DeduplicateBy returns a new *ErrorGroup with at most one error per
+// key returned by the given function, keeping the first occurrence.
+func (g *ErrorGroup) DeduplicateBy(key func(err Error) string) *ErrorGroup {
+	eg := NewErrorGroup()
+	if g == nil {
+		return eg
+	}
+	if g.Formatter != nil {
+		eg.Formatter = g.Formatter
+	}
+	eg.Errors = SliceDistinctBy(g.Errors, key)
+	return eg
+}
+
+// Merge returns a new *ErrorGroup containing the errors from this group
+// followed by the errors from the other, keeping this group's Formatter.
+func (g *ErrorGroup) Merge(other *ErrorGroup) *ErrorGroup {
+	eg := NewErrorGroup()
+	if g != nil {
+		if g.Formatter != nil {
+			eg.Formatter = g.Formatter
+		}
+		eg.Errors = append(eg.Errors, g.Errors...)
+	}
+	if other != nil {
+		eg.Errors = append(eg.Errors, other.Errors...)
+	}
+	return eg
+}
+
+// Take returns a new *ErrorGroup with at most the first n errors of the group.
+func (g *ErrorGroup) Take(n int) *ErrorGroup {
+	eg := g.Merge(nil)
+	eg.Errors = eg.Errors[:min(max(n, 0), len(eg.Errors))]
+	return eg
+}
+
+// Skip returns a new *ErrorGroup without the first n errors of the group.
+func (g *ErrorGroup) Skip(n int) *ErrorGroup {
+	eg := g.Merge(nil)
+	eg.Errors = eg.Errors[min(max(n, 0), len(eg.Errors)):]
+	return eg
+}
+
+// Filter returns a new *ErrorGroup containing only the errors in the group
+// that match the predicate function, keeping the group's Formatter.
+func (g *ErrorGroup) Filter(predicate Predicate[Error]) *ErrorGroup {
+	eg := NewErrorGroup()
+	if g == nil {
+		return eg
+	}
+	if g.Formatter != nil {
+		eg.Formatter = g.Formatter
+	}
+	eg.Errors = SliceFilter(g.Errors, predicate)
+	return eg
+}
+
+// First returns the first error in the group that matches the predicate function.
+func (g *ErrorGroup) First(predicate Predicate[Error]) (Error, bool) {
+	if g == nil {
+		return Error{}, false
+	}
+	for _, err := range g.Errors {
+		if predicate(err) {
+			return err, true
+		}
+	}
+	return Error{}, false
+}
+
+// FindByCode returns a copy of the first error in the group with the given
+// namespace and code.
+func (g *ErrorGroup) FindByCode(namespace string, code ErrorCode) (*Error, bool) {
+	err, ok := g.First(func(err Error) bool {
+		return err.IsCode(namespace, code)
+	})
+	if !ok {
+		return nil, false
+	}
+	return &err, true
+}
+
+// FindAllByCode returns all errors in the group with the given namespace and code.
+func (g *ErrorGroup) FindAllByCode(namespace string, code ErrorCode) []Error {
+	return g.Filter(func(err Error) bool {
+		return err.IsCode(namespace, code)
+	}).Errors
+}
+
+// FindByFlag returns all errors in the group that have the given flag.
+//
+// If the flag contains multiple bits, errors with any of them set match.
+func (g *ErrorGroup) FindByFlag(flag Bitmask) []Error {
+	return g.Filter(func(err Error) bool {
+		return err.Flags.Has(flag)
+	}).Errors
+}
+
+// AnyByFlag returns true if any error in the group has the given flag.
+func (g *ErrorGroup) AnyByFlag(flag Bitmask) bool {
+	_, ok := g.First(func(err Error) bool {
+		return err.Flags.Has(flag)
+	})
+	return ok
+}
+
+// AllByFlag returns true if every error in the group has the given flag.
+//
+// An empty group always returns true.
+func (g *ErrorGroup) AllByFlag(flag Bitmask) bool {
+	_, ok := g.First(func(err Error) bool {
+		return !err.Flags.Has(flag)
+	})
+	return !ok
+}
+
+// Last returns the last error in the group that matches the predicate function.
+func (g *ErrorGroup) Last(predicate Predicate[Error]) (Error, bool) {
+	if g == nil {
+		return Error{}, false
+	}
+	for i := len(g.Errors) - 1; i >= 0; i-- {
+		if predicate(g.Errors[i]) {
+			return g.Errors[i], true
+		}
+	}
+	return Error{}, false
+}
+
 // ErrorOrNil returns an error interface if this Error represents
 // a list of errors, or returns nil if the list of errors is empty. This
 // function is useful at the end of accumulation to make sure that the value
@@ -616,6 +2007,57 @@ func (g *ErrorGroup) Error() string {
 	return g.Formatter(g.Errors)
 }
 
+// Format returns a complex string representation of the ErrorGroup
+// for the given verbs.
+//
+// The '%+v' verb outputs each error in the group along with its full
+// chain of wrapped errors, one per line.
+//
+// Interface: fmt.Formatter.
+func (g *ErrorGroup) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			if _, err := io.WriteString(s, g.verbose()); err != nil {
+				panic(err)
+			}
+			return
+		}
+		fallthrough
+	case 's':
+		if _, err := io.WriteString(s, g.Error()); err != nil {
+			panic(err)
+		}
+	case 'q':
+		if _, err := io.WriteString(s, g.Error()); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// verbose returns a multi-line string representation of the ErrorGroup
+// that includes the chain of wrapped errors for each error in the group.
+func (g *ErrorGroup) verbose() string {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("%d error(s) occurred:", g.Len()))
+	for _, err := range g.Errors {
+		sb.WriteString(fmt.Sprintf("\n* [%s:%s] %s", err.Namespace, err.Code, err.Message))
+
+		next := err.Wrapped
+		for next != nil {
+			we, ok := next.(Error)
+			if !ok {
+				sb.WriteString(fmt.Sprintf("\n  -> %s", next.Error()))
+				break
+			}
+			sb.WriteString(fmt.Sprintf("\n  -> [%s:%s] %s", we.Namespace, we.Code, we.Message))
+			next = we.Wrapped
+		}
+	}
+	return sb.String()
+}
+
 // Len returns the number of errors in the group.
 //
 // Interface: sort.Interface.
@@ -653,6 +2095,32 @@ func (g *ErrorGroup) Translate(translate ErrorTranslate) {
 	}
 }
 
+// WrapAll returns a new *ErrorGroup where each error in the group
+// is wrapped by the given sentinel.
+//
+// If the sentinel is a zero value, ErrUndefined is used instead.
+func (g *ErrorGroup) WrapAll(sentinel Error) *ErrorGroup {
+	if sentinel.IsZero() {
+		sentinel = ErrUndefined
+	}
+	eg := NewErrorGroup()
+	if g == nil {
+		return eg
+	}
+	if g.Formatter != nil {
+		eg.Formatter = g.Formatter
+	}
+	for _, err := range g.Errors {
+		eg.Errors = append(eg.Errors, sentinel.Wrap(err))
+	}
+	return eg
+}
+
+// AsJSON returns the JSON encoding of the ErrorGroup.
+func (g *ErrorGroup) AsJSON() ([]byte, error) {
+	return jsonMarshal(g)
+}
+
 // ErrorTranslate defines function that can translate errors between
 // two different contexts.
 //
@@ -664,19 +2132,38 @@ type ErrorTranslate func(err error) error
 //
 // If err is not already an ErrorGroup, then it will be turned into
 // one. If any of the errs are ErrorGroup, they will be flattened
-// one level into err.
+// one level into err. An Error that wraps a group is not flattened.
 // Any nil errors within errs will be ignored. If err is nil, a new
 // *ErrorGroup will be returned containing the given errs.
 func ErrorJoin(err error, errs ...error) *ErrorGroup {
 	var eg *ErrorGroup
-
-	switch {
-	case errors.As(err, &eg):
+	if _, ok := err.(Error); !ok && errors.As(err, &eg) && eg != nil {
 		eg.Append(errs...)
 		return eg
-	default:
-		eg = NewErrorGroup()
-		eg.Append(SliceFlatten([]error{err}, errs)...)
-		return eg
 	}
+	eg = NewErrorGroup()
+	eg.Append(SliceFlatten([]error{err}, errs)...)
+	return eg
+}
+
+// MergeErrorGroups returns a new *ErrorGroup containing the errors from
+// all given groups in order, keeping the Formatter of the first non-nil group.
+//
+// Any nil groups are ignored.
+func MergeErrorGroups(groups ...*ErrorGroup) *ErrorGroup {
+	var eg *ErrorGroup
+	for _, g := range groups {
+		switch {
+		case g == nil:
+			continue
+		case eg == nil:
+			eg = g.Merge(nil)
+		default:
+			eg = eg.Merge(g)
+		}
+	}
+	if eg == nil {
+		return NewErrorGroup()
+	}
+	return eg
 }
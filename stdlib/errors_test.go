@@ -0,0 +1,1362 @@
+package stdlib_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+var (
+	errA = stdlib.Error{Code: "a", Message: "error a", Namespace: "test"}
+	errB = stdlib.Error{Code: "b", Message: "error b", Namespace: "test"}
+	errC = stdlib.Error{Code: "c", Message: "error c", Namespace: "test"}
+)
+
+func TestErrorGroup_WrapAll(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA, errB)
+	g.Formatter = func(errs []stdlib.Error) string { return "custom" }
+
+	got := g.WrapAll(errC)
+	test.Equal(got.Len(), 2)
+	test.Equal(got.Errors[0].Key(), errC.Key())
+	test.Equal(got.Errors[0].Wrapped, error(errA))
+	test.Equal(got.Errors[1].Key(), errC.Key())
+	test.Equal(got.Errors[1].Wrapped, error(errB))
+	test.Equal(got.Error(), "custom")
+	test.Equal(g.Errors, []stdlib.Error{errA, errB})
+
+	got = stdlib.NewErrorGroup(errA).WrapAll(stdlib.Error{})
+	test.Equal(got.Errors[0].Key(), stdlib.ErrUndefined.Key())
+
+	var nilGroup *stdlib.ErrorGroup
+	test.Equal(nilGroup.WrapAll(errC).Len(), 0)
+}
+
+func TestErrorGroup_Format(t *testing.T) {
+	g := stdlib.NewErrorGroup(errA, errB.Wrap(errC.Wrap(errors.New("io"))))
+	plain := "\n* [test:a] error a\n* [test:b] error b\n-> [test:c] error c\n-> io\n\n"
+
+	stdtest.Table[string, string]{
+		"v": {Got: "%v", Want: plain},
+		"s": {Got: "%s", Want: plain},
+		"q": {Got: "%q", Want: plain},
+		"+v": {
+			Got:  "%+v",
+			Want: "2 error(s) occurred:\n* [test:a] error a\n* [test:b] error b\n  -> [test:c] error c\n  -> io",
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[string, string]) {
+		got := fmt.Sprintf(tc.Got, g)
+		t.Equal(got, tc.Want)
+		t.Equal(fmt.Sprintf(tc.Got, g), got)
+	})
+}
+
+func TestError_AsJSON(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	want := errA.
+		Annotate("k", "v").
+		WithFlag(stdlib.ErrorFlagRetryable).
+		WithMetadata("request_id", "1").
+		WithOperation("op").
+		WithRetry(stdlib.RetryExtras{Delay: time.Second, Backoff: stdlib.BackoffStrategyLinear}).
+		WithTimestamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		WithUserMessage("try again").
+		Wrap(errB.Wrap(errors.New("io")))
+
+	b, err := want.AsJSON()
+	test.OK(err)
+	test.True(json.Valid(b), "invalid JSON: %s", b)
+	test.Equal(want.AsJSONString(), string(b))
+
+	var got stdlib.Error
+	test.OK(json.Unmarshal(b, &got))
+	test.Equal(got.Diff(want), stdlib.ErrorDiff{})
+	test.Equal(got.Depth(), 2)
+	test.Equal(got.Cause().Error(), "io")
+}
+
+func TestError_AsJSONString_Panics(t *testing.T) {
+	test := stdtest.NewTest(t, stdtest.WithTestParallel(false))
+
+	restore := stdlib.SetJSONMarshal(func(any) ([]byte, error) {
+		return nil, errors.New("marshal failed")
+	})
+	defer restore()
+
+	_, err := errA.AsJSON()
+	test.NotOK(err)
+	_, err = stdlib.NewErrorGroup(errA).AsJSON()
+	test.NotOK(err)
+	test.Panic(func() { _ = errA.AsJSONString() })
+}
+
+func TestErrorGroup_AsJSON(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	want := stdlib.NewErrorGroup(errA, errB)
+	b, err := want.AsJSON()
+	test.OK(err)
+
+	var got stdlib.ErrorGroup
+	test.OK(json.Unmarshal(b, &got))
+	test.Equal(got.Len(), 2)
+	test.True(got.Errors[0].Equal(errA), "got %v", got.Errors[0])
+	test.True(got.Errors[1].Equal(errB), "got %v", got.Errors[1])
+}
+
+func TestErrorGroup_Range(t *testing.T) {
+	type want struct {
+		indices []int
+		keys    []string
+	}
+
+	stdtest.Table[*stdlib.ErrorGroup, want]{
+		"full iteration": {
+			Got:  stdlib.NewErrorGroup(errA, errC, errA),
+			Want: want{indices: []int{0, 1, 2}, keys: []string{"test/a", "test/c", "test/a"}},
+		},
+		"early exit": {
+			Got:  stdlib.NewErrorGroup(errA, errB, errA, errC),
+			Want: want{indices: []int{0, 1}, keys: []string{"test/a", "test/b"}},
+		},
+		"empty group": {
+			Got:  stdlib.NewErrorGroup(),
+			Want: want{},
+		},
+		"nil group": {
+			Got:  nil,
+			Want: want{},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[*stdlib.ErrorGroup, want]) {
+		var got want
+		tc.Got.Range(func(i int, err stdlib.Error) bool {
+			got.indices = append(got.indices, i)
+			got.keys = append(got.keys, err.Key())
+			return err.Code != "b"
+		})
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestError_WithOperation(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithOperation("db.query")
+	test.Equal(err.Extras.Operation, "db.query")
+	test.Equal(err.Error(), "[test:a] db.query: error a")
+	test.Equal(errA.Error(), "[test:a] error a")
+	test.Equal(errA.Extras.Operation, "")
+
+	wrapped := err.Wrap(errB)
+	test.Equal(wrapped.Extras.Operation, "db.query")
+	test.Equal(wrapped.Copy().Extras.Operation, "db.query")
+	test.Equal(wrapped.Error(), "[test:a] db.query: error a\n-> [test:b] error b")
+
+	test.Equal(errA.Wrap(errB.WithOperation("cache.get")).Copy().Wrapped.(stdlib.Error).Extras.Operation, "cache.get")
+
+	test.True(err.Equal(errA), "operation must not be compared by Equal")
+	test.True(errors.Is(err, errA), "errors.Is must match a sentinel with an operation added")
+}
+
+func TestError_Gob(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	want := errA.WithOperation("op").WithTag("x").Wrap(errB.Wrap(errC))
+
+	var buf bytes.Buffer
+	test.OK(gob.NewEncoder(&buf).Encode(want))
+
+	var got stdlib.Error
+	test.OK(gob.NewDecoder(&buf).Decode(&got))
+	test.Equal(got, want)
+}
+
+func TestError_Gob_DropsNonError(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var buf bytes.Buffer
+	test.OK(gob.NewEncoder(&buf).Encode(errA.Wrap(errors.New("io"))))
+
+	var got stdlib.Error
+	test.OK(gob.NewDecoder(&buf).Decode(&got))
+	test.True(got.Equal(errA), "decoded error must equal the original")
+	test.Equal(got.Wrapped, nil)
+}
+
+func TestError_Gob_FmtWrapped(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var buf bytes.Buffer
+	test.OK(gob.NewEncoder(&buf).Encode(errA.Wrap(fmt.Errorf("layer: %w", errB.Wrap(errC)))))
+
+	var got stdlib.Error
+	test.OK(gob.NewDecoder(&buf).Decode(&got))
+	test.True(got.Equal(errA), "decoded error must equal the original")
+	test.Equal(got.Wrapped, error(errB.Wrap(errC)))
+	test.Equal(got.Depth(), 2)
+}
+
+func TestError_WithCause(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	root := errors.New("connection refused")
+
+	caused := errA.WithCause(root)
+	test.Equal(caused.Cause(), root)
+	test.Equal(caused.Unwrap(), nil)
+	test.False(errors.Is(caused, root), "cause must not be part of the wrapped chain")
+
+	both := errA.Wrap(errB).WithCause(root)
+	test.Equal(both.Cause(), root)
+	test.Equal(both.Unwrap(), error(errB))
+	test.Equal(both.Wrap(errC).Cause(), root)
+	test.Equal(errA.Extras.Cause, nil)
+}
+
+func TestError_Matches(t *testing.T) {
+	errDB := stdlib.Error{Code: "conn-refused.v2", Namespace: "db.pg"}
+
+	type args struct {
+		err     stdlib.Error
+		pattern string
+	}
+
+	stdtest.Table[args, bool]{
+		"exact":                {Got: args{err: errA, pattern: "test/a"}, Want: true},
+		"namespace wildcard":   {Got: args{err: errA, pattern: "test/*"}, Want: true},
+		"code wildcard":        {Got: args{err: errA, pattern: "*/a"}, Want: true},
+		"all wildcard":         {Got: args{err: errA, pattern: "*/*"}, Want: true},
+		"single character":     {Got: args{err: errA, pattern: "te?t/?"}, Want: true},
+		"character class":      {Got: args{err: errB, pattern: "test/[ab]"}, Want: true},
+		"no match":             {Got: args{err: errC, pattern: "test/[ab]"}, Want: false},
+		"star excludes slash":  {Got: args{err: errA, pattern: "*"}, Want: false},
+		"special characters":   {Got: args{err: errDB, pattern: "db.pg/conn-refused.*"}, Want: true},
+		"literal dot":          {Got: args{err: errDB, pattern: "db?pg/*"}, Want: true},
+		"escaped wildcard":     {Got: args{err: errDB, pattern: "db.pg/conn\\*"}, Want: false},
+		"malformed pattern":    {Got: args{err: errA, pattern: "test/["}, Want: false},
+		"undefined namespace":  {Got: args{err: stdlib.ErrUndefined, pattern: "stdlibx-go/*"}, Want: true},
+		"undefined other code": {Got: args{err: stdlib.ErrUndefined, pattern: "stdlibx-go/timeout"}, Want: false},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, bool]) {
+		t.Equal(tc.Got.err.Matches(tc.Got.pattern), tc.Want)
+	})
+}
+
+func TestErrorCode(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	const code stdlib.ErrorCode = "a"
+	raw := "a"
+	e := stdlib.Error{Code: code, Namespace: "test"}
+	test.Equal(e, stdlib.Error{Code: "a", Namespace: "test"})
+	test.Equal(e, stdlib.Error{Code: stdlib.ErrorCode(raw), Namespace: "test"})
+	test.Equal(code.String(), "a")
+
+	test.Equal(stdlib.ErrorKey("test", raw), "test/a")
+	test.Equal(stdlib.ErrorKey("test", code), "test/a")
+	test.Equal(e.Key(), stdlib.ErrorKey("test", raw))
+
+	b, err := json.Marshal(e)
+	test.OK(err)
+	test.True(strings.Contains(string(b), `"code":"a"`), "code must encode as a string: %s", b)
+
+	var got stdlib.Error
+	test.OK(json.Unmarshal(b, &got))
+	test.Equal(got.Code, code)
+}
+
+func TestError_Equal(t *testing.T) {
+	stdtest.Table[stdlib.Error, bool]{
+		"identical":   {Got: errA, Want: true},
+		"component":   {Got: errA.WithComponent("db"), Want: true},
+		"deprecation": {Got: errA.WithDeprecation(stdlib.DeprecationExtras{Replacement: "test/b"}), Want: true},
+		"duration":    {Got: errA.WithDuration(time.Second), Want: true},
+		"http method": {Got: errA.WithHTTPMethod("GET"), Want: true},
+		"http path":   {Got: errA.WithHTTPPath("/x"), Want: true},
+		"operation":   {Got: errA.WithOperation("op"), Want: true},
+		"severity":    {Got: errA.WithSeverity(stdlib.ErrorSeverityWarn), Want: true},
+		"annotation":  {Got: errA.Annotate("k", "v"), Want: false},
+		"metadata":    {Got: errA.WithMetadata("k", "v"), Want: false},
+		"message":     {Got: stdlib.Error{Code: "a", Message: "changed", Namespace: "test"}, Want: false},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, bool]) {
+		t.Equal(errA.Equal(tc.Got), tc.Want)
+		t.Equal(errors.Is(tc.Got, errA), tc.Want)
+	})
+}
+
+func TestError_WithDuration(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithDuration(1500 * time.Millisecond).WithOperation("op")
+	test.Equal(err.Extras.Duration, 1500*time.Millisecond)
+	test.Equal(err.Wrap(errB).Extras.Duration, 1500*time.Millisecond)
+	test.Equal(err.Copy().Extras.Duration, 1500*time.Millisecond)
+	test.Equal(errA.Extras.Duration, time.Duration(0))
+
+	b, jerr := json.Marshal(err)
+	test.OK(jerr)
+	test.True(strings.Contains(string(b), `"duration":1500000000`), "duration must encode as nanoseconds: %s", b)
+
+	var got stdlib.Error
+	test.OK(json.Unmarshal(b, &got))
+	test.Equal(got.Extras.Duration, 1500*time.Millisecond)
+	test.True(errors.Is(errA.WithDuration(time.Second), errA), "errors.Is must match a sentinel with a duration added")
+
+	b, jerr = json.Marshal(errA)
+	test.OK(jerr)
+	test.False(strings.Contains(string(b), "duration"), "zero duration must be omitted: %s", b)
+}
+
+// statusError is a non-Error type used to test matching with 'errors.As'.
+type statusError struct {
+	status int
+}
+
+func (e *statusError) Error() string {
+	return "status " + strconv.Itoa(e.status)
+}
+
+func TestErrorGroup_ErrorsAs(t *testing.T) {
+	wrapped404 := errA.Wrap(&statusError{status: 404})
+	wrapped500 := errB.Wrap(&statusError{status: 500})
+
+	stdtest.Table[*stdlib.ErrorGroup, []stdlib.Error]{
+		"nil group":       {Got: nil, Want: nil},
+		"empty group":     {Got: stdlib.NewErrorGroup(), Want: nil},
+		"no matches":      {Got: stdlib.NewErrorGroup(errA, errB), Want: nil},
+		"partial matches": {Got: stdlib.NewErrorGroup(errA, wrapped404, errC), Want: []stdlib.Error{wrapped404}},
+		"all matches":     {Got: stdlib.NewErrorGroup(wrapped404, wrapped500), Want: []stdlib.Error{wrapped404, wrapped500}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[*stdlib.ErrorGroup, []stdlib.Error]) {
+		var target *statusError
+		t.Equal(tc.Got.ErrorsAs(&target), tc.Want)
+	})
+}
+
+func TestError_WithComponent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithComponent("database").WithOperation("query")
+	test.Equal(err.Extras.Component, "database")
+	test.Equal(err.Extras.Operation, "query")
+	test.Equal(err.WithTag("x").Extras.Component, "database")
+	test.Equal(err.Wrap(errB).Extras.Component, "database")
+	test.Equal(err.Copy().Extras.Component, "database")
+	test.Equal(errA.Extras.Component, "")
+}
+
+func TestErrorGroup_ByComponent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	dbA := errA.WithComponent("database")
+	dbB := errB.WithComponent("database")
+	cacheC := errC.WithComponent("cache")
+
+	got := stdlib.NewErrorGroup(dbA, errB, cacheC, dbB).ByComponent()
+	test.Equal(len(got), 2)
+	test.Equal(got["database"].Errors, []stdlib.Error{dbA, dbB})
+	test.Equal(got["cache"].Errors, []stdlib.Error{cacheC})
+
+	_, ok := got[""]
+	test.False(ok, "errors without a component must be excluded")
+	test.Equal(stdlib.NewErrorGroup(errA).ByComponent(), map[string]*stdlib.ErrorGroup{})
+}
+
+func TestErrorGroup_ByComponent_Formatter(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA.WithComponent("database"), errB.WithComponent("cache"))
+	g.Formatter = func(errs []stdlib.Error) string { return "custom" }
+
+	for component, eg := range g.ByComponent() {
+		test.True(eg.Error() == "custom", "component %q must keep the formatter", component)
+	}
+
+	var nilGroup *stdlib.ErrorGroup
+	test.Equal(nilGroup.ByComponent(), map[string]*stdlib.ErrorGroup{})
+}
+
+func TestCaptureStack(t *testing.T) {
+	test := stdtest.NewTest(t, stdtest.WithTestParallel(false))
+
+	err, file, line := stdlib.CaptureStack(errA), "", 0
+	if _, f, l, ok := runtime.Caller(0); ok {
+		file, line = f, l-1
+	}
+
+	stack := err.Extras.Debug.StackTrace
+	test.True(strings.Contains(stack, "TestCaptureStack"), "stack must contain the calling function:\n%s", stack)
+	test.True(strings.Contains(stack, fmt.Sprintf("%s:%d", file, line)), "stack must contain %s:%d:\n%s", file, line, stack)
+	test.False(strings.Contains(stack, "stdlib.CaptureStack"), "stack must not contain CaptureStack:\n%s", stack)
+	test.Equal(err.Key(), errA.Key())
+	test.Equal(err.Message, errA.Message)
+
+	original := stdlib.StackTraceDepth
+	stdlib.StackTraceDepth = 0
+	defer func() { stdlib.StackTraceDepth = original }()
+
+	test.Equal(stdlib.CaptureStack(errA).Extras.Debug.StackTrace, "")
+}
+
+// cycleError is an error that unwraps to itself, forming a cycle.
+type cycleError struct{}
+
+func (e *cycleError) Error() string { return "cycle" }
+
+func (e *cycleError) Unwrap() error { return e }
+
+func TestError_Flatten(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	io := errors.New("io")
+	layer := fmt.Errorf("layer: %w", errB.Wrap(io))
+	err := errA.Wrap(layer)
+
+	got := err.Flatten()
+	test.Equal(len(got), 4)
+	test.Equal(got[0], error(err))
+	test.Equal(got[1], layer)
+	test.Equal(got[2], error(errB.Wrap(io)))
+	test.Equal(got[3], io)
+
+	test.Equal(errA.Flatten(), []error{errA})
+}
+
+func TestError_Flatten_Cycle(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	got := errA.Wrap(&cycleError{}).Flatten()
+	test.Equal(len(got), 1001)
+	test.Equal(got[0], error(errA.Wrap(&cycleError{})))
+}
+
+func TestError_Wrap_ErrorGroup(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	io := errors.New("io")
+	err := errA.Wrap(stdlib.NewErrorGroup(errB.Wrap(io), errC))
+
+	test.True(errors.Is(err, errA), "errors.Is must match the outer error")
+	test.True(errors.Is(err, errB), "errors.Is must match the first group member")
+	test.True(errors.Is(err, errC), "errors.Is must match the second group member")
+	test.True(errors.Is(err, io), "errors.Is must match through a group member")
+
+	var eg *stdlib.ErrorGroup
+	test.True(errors.As(err, &eg), "errors.As must find the wrapped group")
+	test.Equal(eg.Len(), 2)
+
+	var keys []string
+	for _, e := range err.AsGroup().Errors {
+		keys = append(keys, e.Key())
+	}
+	test.Equal(keys, []string{errA.Key(), errB.Key(), stdlib.ErrUndefined.Key(), errC.Key()})
+
+	err = errA.Wrap(fmt.Errorf("ctx: %w", stdlib.NewErrorGroup(errB.Wrap(io), errC)))
+	test.True(errors.Is(err, errC), "errors.Is must match through a fmt wrapped group")
+	test.True(errors.Is(err, io), "errors.Is must match through a fmt wrapped group member")
+	test.True(errors.As(err, &eg), "errors.As must find a fmt wrapped group")
+	test.Equal(eg.Len(), 2)
+}
+
+func TestErrorGroup_Append_WrappedGroup(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.Wrap(stdlib.NewErrorGroup(errB, errC))
+
+	g := stdlib.NewErrorGroup()
+	g.Append(err)
+	test.Equal(g.Errors, []stdlib.Error{err})
+
+	test.Equal(stdlib.ErrorJoin(err, errB).Errors, []stdlib.Error{err, errB})
+	test.Equal(stdlib.ErrorJoin(stdlib.NewErrorGroup(errA, errB), errC).Len(), 3)
+}
+
+func TestErrorGroup_Append_FmtWrappedGroup(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	wrapped := fmt.Errorf("ctx: %w", stdlib.NewErrorGroup(errA, errB, errC))
+
+	g := stdlib.NewErrorGroup()
+	g.Append(wrapped)
+	test.Equal(g.Errors, []stdlib.Error{errA, errB, errC})
+
+	test.Equal(stdlib.ErrorJoin(wrapped, errA).Len(), 4)
+	test.Equal(stdlib.ErrorJoin(fmt.Errorf("%w", stdlib.NewErrorGroup(errA, errB, errC)), errB).Len(), 4)
+}
+
+func TestError_Depth(t *testing.T) {
+	io := errors.New("io")
+
+	stdtest.Table[stdlib.Error, int]{
+		"unwrapped":   {Got: errA, Want: 0},
+		"one":         {Got: errA.Wrap(errB), Want: 1},
+		"three deep":  {Got: errA.Wrap(errB.Wrap(errC.Wrap(io))), Want: 3},
+		"mixed chain": {Got: errA.Wrap(fmt.Errorf("ctx: %w", errB.Wrap(io))), Want: 3},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, int]) {
+		t.Equal(tc.Got.Depth(), tc.Want)
+	})
+}
+
+func TestErrorFlag_Distinct(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	flags := []stdlib.Bitmask{
+		stdlib.ErrorFlagUnknown,
+		stdlib.ErrorFlagRetryable,
+		stdlib.ErrorFlagTimeout,
+		stdlib.ErrorFlagPermanent,
+		stdlib.ErrorFlagDeprecated,
+	}
+
+	var seen stdlib.Bitmask
+	for _, flag := range flags {
+		test.True(flag != 0 && flag&(flag-1) == 0, "flag %d must be a power of two", flag)
+		test.False(seen.Has(flag), "flag %d must be distinct", flag)
+		seen = seen.Set(flag)
+	}
+}
+
+func TestError_IsPermanent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	transient := errA.WithFlag(stdlib.ErrorFlagUnknown)
+	test.True(transient.IsTransient(), "unknown errors must be transient")
+	test.False(transient.IsPermanent(), "unknown errors must not be permanent")
+
+	permanent := transient.WithFlag(stdlib.ErrorFlagPermanent)
+	test.True(permanent.IsPermanent(), "permanent flag must be reported")
+	test.False(permanent.IsTransient(), "permanent errors must never be transient")
+
+	test.True(errA.WithFlag(stdlib.ErrorFlagDeprecated).IsDeprecated(), "deprecated flag must be reported")
+	test.False(errA.IsDeprecated(), "errors must not be deprecated by default")
+}
+
+func TestError_WithSeverity(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithSeverity(stdlib.ErrorSeverityWarn)
+	test.Equal(err.Extras.Severity, stdlib.ErrorSeverityWarn)
+	test.False(err.Extras.IsZero(), "extras with only a severity must not be zero")
+	test.Equal(err.WithOperation("query").Extras.Severity, stdlib.ErrorSeverityWarn)
+	test.Equal(errA.Extras.Severity, stdlib.ErrorSeverity(""))
+}
+
+func TestError_WithMaxAttempts(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	want := errA.WithMaxAttempts(3).WithRetry(stdlib.RetryExtras{Delay: time.Second})
+	test.Equal(want.Extras.Retry, stdlib.RetryExtras{Delay: time.Second, MaxAttempts: 3})
+	test.Equal(want.WithRetry(stdlib.RetryExtras{MaxAttempts: 5}).Extras.Retry.MaxAttempts, 5)
+
+	b, err := json.Marshal(want)
+	test.OK(err)
+
+	var got stdlib.Error
+	test.OK(json.Unmarshal(b, &got))
+	test.Equal(got.Extras.Retry, want.Extras.Retry)
+	test.True(strings.Contains(string(b), `"MaxAttempts":3`), "max attempts must be encoded: %s", b)
+
+	b, err = json.Marshal(stdlib.RetryExtras{Delay: time.Second})
+	test.OK(err)
+	test.False(strings.Contains(string(b), "MaxAttempts"), "zero max attempts must be omitted: %s", b)
+}
+
+func TestRetryExtras_IsZero(t *testing.T) {
+	stdtest.Table[stdlib.RetryExtras, bool]{
+		"zero":         {Got: stdlib.RetryExtras{}, Want: true},
+		"delay":        {Got: stdlib.RetryExtras{Delay: time.Second}, Want: false},
+		"max attempts": {Got: stdlib.RetryExtras{MaxAttempts: 1}, Want: false},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.RetryExtras, bool]) {
+		t.Equal(tc.Got.IsZero(), tc.Want)
+	})
+}
+
+func TestRetryExtras_MarshalJSON(t *testing.T) {
+	stdtest.Table[stdlib.RetryExtras, string]{
+		"delay only": {
+			Got:  stdlib.RetryExtras{Delay: time.Second},
+			Want: `{"Delay":1000000000}`,
+		},
+		"all": {
+			Got:  stdlib.RetryExtras{Delay: time.Second, MaxAttempts: 3, Backoff: stdlib.BackoffStrategyExponential, MaxDelay: time.Minute},
+			Want: `{"Delay":1000000000,"MaxAttempts":3,"Backoff":"exponential","MaxDelay":60000000000}`,
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.RetryExtras, string]) {
+		b, err := json.Marshal(tc.Got)
+		t.OK(err)
+		t.Equal(string(b), tc.Want)
+
+		var got stdlib.RetryExtras
+		t.OK(json.Unmarshal(b, &got))
+		t.Equal(got, tc.Got)
+	})
+}
+
+func TestRetryExtras_ComputeDelay(t *testing.T) {
+	type args struct {
+		extras  stdlib.RetryExtras
+		attempt int
+	}
+
+	second := time.Second
+	stdtest.Table[args, time.Duration]{
+		"unset": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second}, attempt: 3},
+			Want: second,
+		},
+		"constant": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyConstant}, attempt: 3},
+			Want: second,
+		},
+		"linear": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyLinear}, attempt: 3},
+			Want: 4 * second,
+		},
+		"exponential": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyExponential}, attempt: 3},
+			Want: 8 * second,
+		},
+		"linear attempt zero": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyLinear}, attempt: 0},
+			Want: second,
+		},
+		"exponential attempt zero": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyExponential}, attempt: 0},
+			Want: second,
+		},
+		"negative attempt": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyExponential}, attempt: -1},
+			Want: second,
+		},
+		"capped": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyExponential, MaxDelay: 5 * second}, attempt: 3},
+			Want: 5 * second,
+		},
+		"overflow capped": {
+			Got:  args{extras: stdlib.RetryExtras{Delay: second, Backoff: stdlib.BackoffStrategyExponential, MaxDelay: 5 * second}, attempt: 100},
+			Want: 5 * second,
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, time.Duration]) {
+		t.Equal(tc.Got.extras.ComputeDelay(tc.Got.attempt), tc.Want)
+		t.Equal(errA.WithRetry(tc.Got.extras).ComputeDelay(tc.Got.attempt), tc.Want)
+	})
+}
+
+func TestErrorGroup_FirstLast(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	first := errA.WithTag("x")
+	last := errB.WithTag("x")
+	g := stdlib.NewErrorGroup(errC, first, errA, last)
+	tagged := func(e stdlib.Error) bool { return len(e.Extras.Tags) > 0 }
+
+	got, ok := g.First(tagged)
+	test.True(ok, "First must find a match")
+	test.Equal(got, first)
+
+	got, ok = g.Last(tagged)
+	test.True(ok, "Last must find a match")
+	test.Equal(got, last)
+
+	never := func(stdlib.Error) bool { return false }
+	_, ok = g.First(never)
+	test.False(ok, "First must not match")
+	_, ok = g.Last(never)
+	test.False(ok, "Last must not match")
+
+	var nilGroup *stdlib.ErrorGroup
+	_, ok = nilGroup.First(tagged)
+	test.False(ok, "First on a nil group must not match")
+	_, ok = nilGroup.Last(tagged)
+	test.False(ok, "Last on a nil group must not match")
+}
+
+func TestError_WithMetadata(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	e1 := errA.WithMetadata("k1", "v1")
+	e2 := e1.WithMetadata("k2", "v2")
+	test.Equal(e1.Extras.Metadata, map[string]string{"k1": "v1"})
+	test.Equal(e2.Extras.Metadata, map[string]string{"k1": "v1", "k2": "v2"})
+	test.Equal(e2.WithOperation("op").Extras.Metadata, e2.Extras.Metadata)
+	test.False(e1.Extras.IsZero(), "extras with only metadata must not be zero")
+	test.False(errA.Equal(e1), "metadata must be compared by Equal")
+}
+
+func TestError_IsZero(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.True(stdlib.Error{}.IsZero(), "zero Error must be zero")
+	test.False(errA.IsZero(), "non-zero Error must not be zero")
+	test.False(stdlib.Error{Wrapped: errA}.IsZero(), "Error wrapping an error must not be zero")
+}
+
+func TestError_Wrap_Zero(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.Error{}.Wrap(errB), errB)
+	test.Equal(stdlib.Error{}.Wrap(errB.Wrap(errC)), errB.Wrap(errC))
+
+	io := errors.New("io")
+	test.Equal(stdlib.Error{}.Wrap(io), stdlib.Error{Wrapped: io})
+}
+
+func TestErrorGroup_Append_Zero(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup()
+	g.Append(stdlib.Error{}, errA, stdlib.Error{})
+	test.Equal(g.Errors, []stdlib.Error{errA})
+}
+
+func TestError_UserError(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(errA.UserError(), errA.Message)
+
+	err := errA.WithUserMessage("please try again")
+	test.Equal(err.UserError(), "please try again")
+	test.Equal(err.Error(), errA.Error())
+	test.Equal(err.Copy().UserMessage, "please try again")
+	test.Equal(err.Wrap(errB).UserMessage, "please try again")
+	test.Equal(err.WithOperation("op").UserError(), "please try again")
+}
+
+func TestError_WithField(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithField("n", 1).WithField("ok", true).WithField("s", "v")
+	test.Equal(err.Extras.Metadata, map[string]string{"n": "1", "ok": "true", "s": "v"})
+	test.Equal(errA.Extras.Metadata, map[string]string(nil))
+}
+
+func TestError_Annotate(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	e1 := errA.Annotate("k1", "v1")
+	e2 := e1.Annotate("k2", "v2")
+	test.Equal(e1.Annotations(), map[string]string{"k1": "v1"})
+	test.Equal(e2.Annotations(), map[string]string{"k1": "v1", "k2": "v2"})
+	test.Equal(e2.WithOperation("op").Annotations(), e2.Annotations())
+	test.Equal(e2.Copy().Annotations(), e2.Annotations())
+	test.Equal(errA.Annotations(), map[string]string(nil))
+
+	annotations := e1.Annotations()
+	annotations["k1"] = "changed"
+	test.Equal(e1.Annotations(), map[string]string{"k1": "v1"})
+}
+
+func TestError_Annotate_Flatten(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.Annotate("layer", "a").Wrap(
+		fmt.Errorf("ctx: %w", errB.Annotate("layer", "b").Wrap(
+			errC.Annotate("layer", "c"),
+		)),
+	)
+
+	var layers []string
+	for _, e := range err.Flatten() {
+		if se, ok := e.(stdlib.Error); ok {
+			layers = append(layers, se.Annotations()["layer"])
+		}
+	}
+	test.Equal(layers, []string{"a", "b", "c"})
+}
+
+func TestError_Age(t *testing.T) {
+	test := stdtest.NewTest(t, stdtest.WithTestParallel(false))
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	original := stdlib.Now
+	stdlib.Now = func() time.Time { return now }
+	defer func() { stdlib.Now = original }()
+
+	err := errA.WithNow()
+	test.Equal(err.CreatedAt, now)
+	test.Equal(err.Age(), time.Duration(0))
+	test.Equal(errA.Age(), time.Duration(0))
+
+	now = now.Add(time.Minute)
+	test.Equal(err.Age(), time.Minute)
+	test.Equal(err.WithOperation("op").CreatedAt, err.CreatedAt)
+}
+
+func TestError_MarshalJSON_CreatedAt(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.False(strings.Contains(errA.AsJSONString(), "created_at"), "zero creation time must be omitted")
+
+	err := errA.Wrap(errB)
+	err.CreatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	test.True(strings.Contains(err.AsJSONString(), `"created_at":"2024-01-01T00:00:00Z"`), "creation time must be encoded: %s", err.AsJSONString())
+}
+
+func TestError_IsExpired(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	original := stdlib.Now
+	stdlib.Now = func() time.Time { return now }
+	defer func() { stdlib.Now = original }()
+
+	stdtest.Table[stdlib.Error, bool]{
+		"no timestamp":   {Got: errA, Want: false},
+		"before ttl":     {Got: errA.WithTimestamp(now.Add(-time.Minute + 1)), Want: false},
+		"exactly at ttl": {Got: errA.WithTimestamp(now.Add(-time.Minute)), Want: false},
+		"after ttl":      {Got: errA.WithTimestamp(now.Add(-time.Minute - 1)), Want: true},
+		"in the future":  {Got: errA.WithTimestamp(now.Add(time.Hour)), Want: false},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, bool]) {
+		t.Equal(tc.Got.IsExpired(time.Minute), tc.Want)
+	}, stdtest.WithTestParallel(false))
+}
+
+func TestError_WithTimestamp(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	want := errA.WithTimestamp(ts)
+	test.Equal(want.CreatedAt, ts)
+	test.Equal(want.Copy().CreatedAt, ts)
+	test.Equal(want.Wrap(errB).CreatedAt, ts)
+
+	b, err := json.Marshal(want)
+	test.OK(err)
+	test.True(strings.Contains(string(b), `"created_at":"`+ts.Format(time.RFC3339)+`"`), "creation time must be RFC3339: %s", b)
+
+	var got stdlib.Error
+	test.OK(json.Unmarshal(b, &got))
+	test.True(got.CreatedAt.Equal(ts), "got %v, want %v", got.CreatedAt, ts)
+
+	b, err = json.Marshal(errA.WithTimestamp(time.Time{}))
+	test.OK(err)
+	test.False(strings.Contains(string(b), "created_at"), "zero creation time must be omitted: %s", b)
+}
+
+func TestError_IsCode(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.True(errA.WithOperation("op").IsCode("test", "a"), "IsCode must ignore extras")
+	test.False(errA.IsCode("other", "a"), "IsCode must compare the namespace")
+	test.False(errA.IsCode("test", "b"), "IsCode must compare the code")
+}
+
+func TestError_MatchByCode(t *testing.T) {
+	stdtest.Table[stdlib.Error, bool]{
+		"same":          {Got: errA, Want: true},
+		"extras":        {Got: errA.WithMetadata("k", "v").WithOperation("op"), Want: true},
+		"wrapped":       {Got: errB.Wrap(errA.WithMetadata("k", "v")), Want: true},
+		"fmt wrapped":   {Got: errB.Wrap(fmt.Errorf("ctx: %w", errA.WithTag("x"))), Want: true},
+		"different":     {Got: errB.Wrap(errC), Want: false},
+		"other message": {Got: stdlib.Error{Code: "a", Message: "changed", Namespace: "test"}, Want: true},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, bool]) {
+		t.Equal(tc.Got.MatchByCode(errA), tc.Want)
+	})
+}
+
+func TestError_MatchByCode_Gob(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var buf bytes.Buffer
+	test.OK(gob.NewEncoder(&buf).Encode(errB.Wrap(errA.WithMetadata("request_id", "1"))))
+
+	var got stdlib.Error
+	test.OK(gob.NewDecoder(&buf).Decode(&got))
+	test.True(got.MatchByCode(errA), "MatchByCode must match after decoding")
+	test.False(errors.Is(got, errA), "errors.Is must not match once extras differ")
+}
+
+func TestNewError(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.NewError("test", "a", "error a"), errA)
+
+	err := stdlib.NewErrorWithFlags("test", "a", "error a", stdlib.ErrorFlagRetryable)
+	test.Equal(err.Key(), errA.Key())
+	test.True(err.IsRetryable(), "flags must be set")
+}
+
+func TestNewError_Invalid(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Panic(func() { stdlib.NewError("", "a", "error a") })
+	test.Panic(func() { stdlib.NewError("test", "", "error a") })
+	test.Panic(func() { stdlib.NewErrorWithFlags("", "", "", stdlib.ErrorFlagTimeout) })
+}
+
+func TestError_Validate(t *testing.T) {
+	stdtest.Table[stdlib.Error, bool]{
+		"valid":             {Got: errA, Want: true},
+		"missing message":   {Got: stdlib.Error{Code: "a", Namespace: "test"}, Want: true},
+		"missing namespace": {Got: stdlib.Error{Code: "a", Message: "error a"}, Want: false},
+		"missing code":      {Got: stdlib.Error{Message: "error a", Namespace: "test"}, Want: false},
+		"zero":              {Got: stdlib.Error{}, Want: false},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, bool]) {
+		err := tc.Got.Validate()
+		if tc.Want {
+			t.OK(err)
+			return
+		}
+		t.EqualError(err, stdlib.ErrInvalidError)
+	})
+}
+
+func TestError_Cause(t *testing.T) {
+	io := errors.New("io")
+
+	stdtest.Table[stdlib.Error, error]{
+		"unwrapped":   {Got: errA, Want: errA},
+		"wrapped":     {Got: errA.Wrap(errB.Wrap(errC)), Want: errC},
+		"mixed chain": {Got: errA.Wrap(fmt.Errorf("ctx: %w", errB.Wrap(io))), Want: io},
+		"with cause":  {Got: errA.Wrap(errB.Wrap(io)).WithCause(errC), Want: errC},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, error]) {
+		t.Equal(tc.Got.Cause(), tc.Want)
+	})
+}
+
+func TestDeprecateError(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := since.AddDate(1, 0, 0)
+
+	err := stdlib.DeprecateError(errA, errB, since, sunset)
+	test.True(err.IsDeprecated(), "deprecated flag must be set")
+	test.Equal(err.Extras.Deprecation, stdlib.DeprecationExtras{Replacement: errB.Key(), Since: since, Sunset: sunset})
+	test.Equal(err.WithOperation("op").Extras.Deprecation, err.Extras.Deprecation)
+	test.False(err.Extras.IsZero(), "extras with only deprecation info must not be zero")
+	test.True(stdlib.DeprecationExtras{}.IsZero(), "zero deprecation info must be zero")
+}
+
+func TestIsDeprecated(t *testing.T) {
+	deprecated := stdlib.DeprecateError(errA, errB, time.Time{}, time.Time{})
+
+	stdtest.Table[error, bool]{
+		"nil":         {Got: nil, Want: false},
+		"plain":       {Got: errors.New("io"), Want: false},
+		"active":      {Got: errA, Want: false},
+		"deprecated":  {Got: deprecated, Want: true},
+		"fmt wrapped": {Got: fmt.Errorf("ctx: %w", deprecated), Want: true},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[error, bool]) {
+		t.Equal(stdlib.IsDeprecated(tc.Got), tc.Want)
+	})
+}
+
+func TestError_Diff(t *testing.T) {
+	stdtest.Table[stdlib.Error, stdlib.ErrorDiff]{
+		"identical": {
+			Got:  errA,
+			Want: stdlib.ErrorDiff{},
+		},
+		"annotations": {
+			Got:  errA.Annotate("k", "v"),
+			Want: stdlib.ErrorDiff{AnnotationsChanged: true},
+		},
+		"code": {
+			Got:  stdlib.Error{Code: "b", Message: "error a", Namespace: "test"},
+			Want: stdlib.ErrorDiff{CodeChanged: true},
+		},
+		"created at": {
+			Got:  errA.WithTimestamp(time.Unix(1, 0)),
+			Want: stdlib.ErrorDiff{CreatedAtChanged: true},
+		},
+		"extras": {
+			Got:  errA.WithOperation("op"),
+			Want: stdlib.ErrorDiff{ExtrasChanged: true},
+		},
+		"flags": {
+			Got:  errA.WithFlag(stdlib.ErrorFlagTimeout),
+			Want: stdlib.ErrorDiff{FlagsChanged: true},
+		},
+		"message": {
+			Got:  stdlib.Error{Code: "a", Message: "changed", Namespace: "test"},
+			Want: stdlib.ErrorDiff{MessageChanged: true},
+		},
+		"namespace": {
+			Got:  stdlib.Error{Code: "a", Message: "error a", Namespace: "other"},
+			Want: stdlib.ErrorDiff{NamespaceChanged: true},
+		},
+		"user message": {
+			Got:  errA.WithUserMessage("x"),
+			Want: stdlib.ErrorDiff{UserMessageChanged: true},
+		},
+		"wrapped": {
+			Got:  errA.Wrap(errB),
+			Want: stdlib.ErrorDiff{WrappedChanged: true},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, stdlib.ErrorDiff]) {
+		diff := errA.Diff(tc.Got)
+		t.Equal(diff, tc.Want)
+		t.Equal(diff.IsZero(), tc.Want == stdlib.ErrorDiff{})
+	})
+}
+
+func TestError_MarshalJSON_Wrapped(t *testing.T) {
+	test := stdtest.NewTest(t, stdtest.WithTestParallel(false))
+
+	err := errA.Wrap(errB.Wrap(errors.New("io")))
+
+	var got map[string]any
+	test.OK(json.Unmarshal([]byte(err.AsJSONString()), &got))
+	wrapped, ok := got["wrapped"].(map[string]any)
+	test.True(ok, "missing wrapped error: %v", got)
+	test.Equal(wrapped["code"], "b")
+	test.Equal(wrapped["wrapped"], map[string]any{"message": "io"})
+
+	stdlib.HideWrappedJSON = true
+	defer func() { stdlib.HideWrappedJSON = false }()
+
+	got = nil
+	test.OK(json.Unmarshal([]byte(err.AsJSONString()), &got))
+	_, ok = got["wrapped"]
+	test.False(ok, "wrapped error must be hidden: %v", got)
+}
+
+func TestError_MarshalJSON_FmtWrapped(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	io := errors.New("io")
+	want := errA.Wrap(fmt.Errorf("layer: %w", errB.Wrap(io)))
+
+	b, err := json.Marshal(want)
+	test.OK(err)
+
+	var got stdlib.Error
+	test.OK(json.Unmarshal(b, &got))
+	test.True(errors.Is(got, errA), "errors.Is must match the outer error")
+	test.True(errors.Is(got, errB), "errors.Is must match an Error beneath a fmt wrapper")
+	test.Equal(got.Depth(), want.Depth())
+	test.Equal(got.Error(), want.Error())
+	test.Equal(errors.Unwrap(got).Error(), "layer: [test:b] error b\n-> io")
+	test.Equal(got.Cause().Error(), "io")
+
+	var inner stdlib.Error
+	test.True(errors.As(errors.Unwrap(got), &inner), "errors.As must find the inner Error")
+	test.Equal(inner.Key(), errB.Key())
+}
+
+func TestError_WithHTTPMethodAndPath(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithHTTPMethod("GET").WithHTTPPath("/users/1")
+	test.Equal(err.Extras.HTTPMethod, "GET")
+	test.Equal(err.Extras.HTTPPath, "/users/1")
+	test.Equal(err.WithOperation("op").Extras.HTTPPath, "/users/1")
+
+	err = errA.WithHTTPPath("/users/1").WithHTTPMethod("POST")
+	test.Equal(err.Extras.HTTPMethod, "POST")
+	test.Equal(err.Extras.HTTPPath, "/users/1")
+	test.Equal(errA.Extras.HTTPPath, "")
+}
+
+func TestErrorGroup_Filter(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA, errB.WithFlag(stdlib.ErrorFlagRetryable), errC)
+	g.Formatter = func(errs []stdlib.Error) string { return "custom" }
+	got := g.Filter(func(err stdlib.Error) bool { return err.IsRetryable() })
+
+	test.Equal(got.Len(), 1)
+	test.Equal(got.Errors[0].Key(), "test/b")
+	test.Equal(got.Error(), "custom")
+	test.Equal(g.Len(), 3)
+	test.Equal(g.Errors[0], errA)
+
+	none := g.Filter(func(stdlib.Error) bool { return false })
+	test.Equal(none.Len(), 0)
+	test.Equal(none.ErrorOrNil(), nil)
+
+	var nilGroup *stdlib.ErrorGroup
+	test.Equal(nilGroup.Filter(func(stdlib.Error) bool { return true }).Len(), 0)
+}
+
+func TestErrorGroup_Find(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA, errB, errA.WithOperation("op"))
+
+	got, ok := g.FindByCode("test", "a")
+	test.True(ok, "error must be found")
+	test.Equal(*got, errA)
+	_, ok = g.FindByCode("test", "z")
+	test.False(ok, "error must not be found")
+	test.Equal(g.FindAllByCode("test", "a"), []stdlib.Error{errA, errA.WithOperation("op")})
+	test.Equal(len(g.FindAllByCode("other", "a")), 0)
+
+	var nilGroup *stdlib.ErrorGroup
+	_, ok = nilGroup.FindByCode("test", "a")
+	test.False(ok, "nil group has no errors")
+}
+
+func TestErrorGroup_ByFlag(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	retryableB := errB.WithFlag(stdlib.ErrorFlagRetryable)
+	timeoutC := errC.WithFlag(stdlib.ErrorFlagTimeout)
+	g := stdlib.NewErrorGroup(errA, retryableB, timeoutC)
+
+	test.Equal(g.FindByFlag(stdlib.ErrorFlagRetryable), []stdlib.Error{retryableB})
+	test.Equal(g.FindByFlag(stdlib.ErrorFlagRetryable|stdlib.ErrorFlagTimeout), []stdlib.Error{retryableB, timeoutC})
+	test.Equal(len(g.FindByFlag(stdlib.ErrorFlagPermanent)), 0)
+
+	test.True(g.AnyByFlag(stdlib.ErrorFlagRetryable), "group has a retryable error")
+	test.False(g.AnyByFlag(stdlib.ErrorFlagPermanent), "group has no permanent error")
+	test.False(g.AllByFlag(stdlib.ErrorFlagRetryable), "group has non-retryable errors")
+	test.True(stdlib.NewErrorGroup(retryableB).AllByFlag(stdlib.ErrorFlagRetryable), "group has only retryable errors")
+	test.True(stdlib.NewErrorGroup().AllByFlag(stdlib.ErrorFlagRetryable), "empty group matches all")
+}
+
+// chainKeys returns the keys of each Error in the wrapped chain of err.
+func chainKeys(err stdlib.Error) []string {
+	var keys []string
+	for _, e := range err.Flatten() {
+		switch v := e.(type) {
+		case stdlib.Error:
+			keys = append(keys, v.Key())
+		case *stdlib.ErrorGroup:
+			return append(keys, "group")
+		}
+	}
+	return keys
+}
+
+func TestErrorGroup_Flatten(t *testing.T) {
+	errD := stdlib.Error{Code: "d", Message: "error d", Namespace: "test"}
+
+	stdtest.Table[*stdlib.ErrorGroup, [][]string]{
+		"depth-1 is a no-op": {
+			Got:  stdlib.NewErrorGroup(errA, errB.Wrap(errC)),
+			Want: [][]string{{"test/a"}, {"test/b", "test/c"}},
+		},
+		"depth-2": {
+			Got:  stdlib.NewErrorGroup(errA.Wrap(stdlib.NewErrorGroup(errB, errC))),
+			Want: [][]string{{"test/a", "test/b"}, {"test/a", "test/c"}},
+		},
+		"depth-3": {
+			Got: stdlib.NewErrorGroup(
+				errA.Wrap(stdlib.NewErrorGroup(errB.Wrap(stdlib.NewErrorGroup(errC, errD)))),
+			),
+			Want: [][]string{{"test/a", "test/b", "test/c"}, {"test/a", "test/b", "test/d"}},
+		},
+		"depth-2 through wrapped error": {
+			Got:  stdlib.NewErrorGroup(errA.Wrap(errB.Wrap(stdlib.NewErrorGroup(errC)))),
+			Want: [][]string{{"test/a", "test/b", "test/c"}},
+		},
+		"mixed depth": {
+			Got: stdlib.NewErrorGroup(
+				errA,
+				errB.Wrap(stdlib.NewErrorGroup(errC)),
+				errC.Wrap(stdlib.NewErrorGroup(errA, errB.Wrap(stdlib.NewErrorGroup(errD)))),
+			),
+			Want: [][]string{
+				{"test/a"},
+				{"test/b", "test/c"},
+				{"test/c", "test/a"},
+				{"test/c", "test/b", "test/d"},
+			},
+		},
+		"empty nested group keeps outer error": {
+			Got:  stdlib.NewErrorGroup(errA.Wrap(stdlib.NewErrorGroup())),
+			Want: [][]string{{"test/a"}},
+		},
+		"empty group": {
+			Got:  stdlib.NewErrorGroup(),
+			Want: nil,
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[*stdlib.ErrorGroup, [][]string]) {
+		var got [][]string
+		for _, err := range tc.Got.Flatten().Errors {
+			got = append(got, chainKeys(err))
+		}
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestErrorGroup_Flatten_Formatter(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA.Wrap(stdlib.NewErrorGroup(errB, errC)))
+	g.Formatter = func(errs []stdlib.Error) string { return "custom" }
+
+	got := g.Flatten()
+	test.Equal(got.Len(), 2)
+	test.Equal(got.Error(), "custom")
+	test.Equal(g.Len(), 1)
+
+	var nilGroup *stdlib.ErrorGroup
+	test.Equal(nilGroup.Flatten().Len(), 0)
+}
+
+func TestErrorGroup_Deduplicate(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA, errB, errA.WithOperation("op"), errors.New("x"), errors.New("y"), errors.New("x"))
+	g.Formatter = func(errs []stdlib.Error) string { return "custom" }
+	got := g.Deduplicate()
+
+	test.Equal(got.Len(), 4)
+	test.Equal(got.Errors[0], errA)
+	test.Equal(got.Errors[1], errB)
+	test.Equal(got.Errors[2].Wrapped.Error(), "x")
+	test.Equal(got.Errors[3].Wrapped.Error(), "y")
+	test.Equal(got.Error(), "custom")
+	test.Equal(g.Len(), 6)
+}
+
+func TestErrorGroup_DeduplicateBy(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA, errB, errC.WithOperation("op"), errA.WithOperation("op"))
+	got := g.DeduplicateBy(func(err stdlib.Error) string { return err.Extras.Operation })
+	test.Equal(got.Errors, []stdlib.Error{errA, errC.WithOperation("op")})
+
+	var nilGroup *stdlib.ErrorGroup
+	test.Equal(nilGroup.Deduplicate().Len(), 0)
+}
+
+func TestErrorGroup_Merge(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g1 := stdlib.NewErrorGroup(errA)
+	g1.Formatter = func(errs []stdlib.Error) string { return "custom" }
+	g2 := stdlib.NewErrorGroup(errB, errC)
+
+	got := g1.Merge(g2)
+	test.Equal(got.Errors, []stdlib.Error{errA, errB, errC})
+	test.Equal(got.Error(), "custom")
+	test.Equal(g1.Len(), 1)
+	test.Equal(g1.Merge(nil).Errors, []stdlib.Error{errA})
+
+	var nilGroup *stdlib.ErrorGroup
+	test.Equal(nilGroup.Merge(g2).Errors, []stdlib.Error{errB, errC})
+}
+
+func TestMergeErrorGroups(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g1 := stdlib.NewErrorGroup(errA)
+	g2 := stdlib.NewErrorGroup(errB, errC)
+
+	test.Equal(stdlib.MergeErrorGroups(nil, g2, nil, g1).Errors, []stdlib.Error{errB, errC, errA})
+	test.Equal(stdlib.MergeErrorGroups().Len(), 0)
+	test.Equal(stdlib.MergeErrorGroups(nil, nil).Len(), 0)
+	test.Equal(g2.Len(), 2)
+}
+
+func TestErrorGroup_TakeSkip(t *testing.T) {
+	g := stdlib.NewErrorGroup(errA, errB, errC)
+
+	stdtest.Table[int, [2][]stdlib.Error]{
+		"negative": {Got: -1, Want: [2][]stdlib.Error{{}, {errA, errB, errC}}},
+		"zero":     {Got: 0, Want: [2][]stdlib.Error{{}, {errA, errB, errC}}},
+		"middle":   {Got: 2, Want: [2][]stdlib.Error{{errA, errB}, {errC}}},
+		"overflow": {Got: 5, Want: [2][]stdlib.Error{{errA, errB, errC}, {}}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[int, [2][]stdlib.Error]) {
+		t.Equal(g.Take(tc.Got).Errors, tc.Want[0])
+		t.Equal(g.Skip(tc.Got).Errors, tc.Want[1])
+		t.Equal(g.Len(), 3)
+	})
+}
+
+func TestError_EqualIgnoreMessage(t *testing.T) {
+	stdtest.Table[stdlib.Error, bool]{
+		"identical": {Got: errA, Want: true},
+		"message":   {Got: stdlib.Error{Code: "a", Message: "reworded", Namespace: "test"}, Want: true},
+		"extras":    {Got: errA.WithOperation("op").WithMetadata("k", "v"), Want: true},
+		"code":      {Got: errB, Want: false},
+		"namespace": {Got: stdlib.Error{Code: "a", Message: "error a", Namespace: "other"}, Want: false},
+		"flags":     {Got: errA.WithFlag(stdlib.ErrorFlagRetryable), Want: false},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Error, bool]) {
+		t.Equal(errA.EqualIgnoreMessage(tc.Got), tc.Want)
+		t.Equal(tc.Got.EqualIgnoreMessage(errA), tc.Want)
+	})
+}
+
+func TestErrorGroup_Range_Copy(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	g := stdlib.NewErrorGroup(errA, errB)
+	g.Range(func(_ int, err stdlib.Error) bool {
+		err.Message = "changed"
+		err.Extras.Tags = append(err.Extras.Tags, "x")
+		return true
+	})
+	test.Equal(g.Errors, []stdlib.Error{errA, errB})
+}
+
+func TestErrorGroup_Stats(t *testing.T) {
+	stdtest.Table[*stdlib.ErrorGroup, stdlib.ErrorGroupStats]{
+		"empty": {
+			Got: stdlib.NewErrorGroup(),
+			Want: stdlib.ErrorGroupStats{
+				ByFlag:      map[uint64]int{},
+				ByNamespace: map[string]int{},
+				ByCode:      map[string]int{},
+			},
+		},
+		"mixed": {
+			Got: stdlib.NewErrorGroup(
+				errA.WithFlag(stdlib.ErrorFlagRetryable|stdlib.ErrorFlagTimeout),
+				errB.WithFlag(stdlib.ErrorFlagRetryable),
+				stdlib.Error{Code: "a", Message: "other a", Namespace: "other"},
+			),
+			Want: stdlib.ErrorGroupStats{
+				Total: 3,
+				ByFlag: map[uint64]int{
+					uint64(stdlib.ErrorFlagRetryable): 2,
+					uint64(stdlib.ErrorFlagTimeout):   1,
+				},
+				ByNamespace: map[string]int{"test": 2, "other": 1},
+				ByCode:      map[string]int{"a": 2, "b": 1},
+			},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[*stdlib.ErrorGroup, stdlib.ErrorGroupStats]) {
+		t.Equal(tc.Got.Stats(), tc.Want)
+	})
+}
+
+func TestDeprecationExtras_MarshalJSON(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stdtest.Table[stdlib.DeprecationExtras, string]{
+		"empty": {
+			Got:  stdlib.DeprecationExtras{},
+			Want: `{}`,
+		},
+		"replacement only": {
+			Got:  stdlib.DeprecationExtras{Replacement: "test/b"},
+			Want: `{"replacement":"test/b"}`,
+		},
+		"since only": {
+			Got:  stdlib.DeprecationExtras{Since: since},
+			Want: `{"since":"2024-01-01T00:00:00Z"}`,
+		},
+		"all": {
+			Got:  stdlib.DeprecationExtras{Replacement: "test/b", Since: since, Sunset: since.AddDate(1, 0, 0)},
+			Want: `{"replacement":"test/b","since":"2024-01-01T00:00:00Z","sunset":"2025-01-01T00:00:00Z"}`,
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.DeprecationExtras, string]) {
+		b, err := json.Marshal(tc.Got)
+		t.OK(err)
+		t.Equal(string(b), tc.Want)
+
+		var got stdlib.DeprecationExtras
+		t.OK(json.Unmarshal(b, &got))
+		t.Equal(got, tc.Got)
+	})
+}
+
+func TestError_MarshalJSON_NoDeprecation(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.False(strings.Contains(errA.AsJSONString(), "0001-01-01"), "zero deprecation times must be omitted")
+}
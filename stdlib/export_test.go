@@ -0,0 +1,8 @@
+package stdlib
+
+// SetJSONMarshal replaces the JSON encoder used by AsJSON until restore is called.
+func SetJSONMarshal(fn func(v any) ([]byte, error)) (restore func()) {
+	original := jsonMarshal
+	jsonMarshal = fn
+	return func() { jsonMarshal = original }
+}
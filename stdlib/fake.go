@@ -7,6 +7,7 @@ import (
 	"golang.org/x/exp/constraints"
 	"math/rand"
 	"regexp"
+	"strings"
 	"sync/atomic"
 	"time"
 )
@@ -42,6 +43,11 @@ type FakeRequest struct {
 // ENUM(unspecified, random, random_range, random_pattern, random_select, distribution_normal, distribution_uniform, stateful).
 type FakeStrategy string
 
+// DisplayName returns the FakeStrategy as title-cased words, e.g. "Random Range".
+func (x FakeStrategy) DisplayName() string {
+	return TitleCase(strings.ReplaceAll(string(x), "_", " "))
+}
+
 // FakeState holds persistent values for some strategies.
 type FakeState[T any] struct {
 	// Generation is the numeric value of the previous generation (incrementing).
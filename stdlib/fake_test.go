@@ -0,0 +1,24 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestFakeStrategy_DisplayName(t *testing.T) {
+	stdtest.Table[stdlib.FakeStrategy, string]{
+		"unspecified":          {Got: stdlib.FakeStrategyUnspecified, Want: "Unspecified"},
+		"random":               {Got: stdlib.FakeStrategyRandom, Want: "Random"},
+		"random_range":         {Got: stdlib.FakeStrategyRandomRange, Want: "Random Range"},
+		"random_pattern":       {Got: stdlib.FakeStrategyRandomPattern, Want: "Random Pattern"},
+		"random_select":        {Got: stdlib.FakeStrategyRandomSelect, Want: "Random Select"},
+		"distribution_normal":  {Got: stdlib.FakeStrategyDistributionNormal, Want: "Distribution Normal"},
+		"distribution_uniform": {Got: stdlib.FakeStrategyDistributionUniform, Want: "Distribution Uniform"},
+		"stateful":             {Got: stdlib.FakeStrategyStateful, Want: "Stateful"},
+		"custom":               {Got: stdlib.FakeStrategy("weighted_random_pick"), Want: "Weighted Random Pick"},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.FakeStrategy, string]) {
+		t.Equal(tc.Got.DisplayName(), tc.Want)
+	})
+}
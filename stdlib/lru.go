@@ -0,0 +1,100 @@
+package stdlib
+
+import (
+	"container/list"
+	"sync"
+)
+
+// NewLRUCache creates a new *LRUCache that holds at most capacity items.
+//
+// A capacity less than one is treated as one.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		capacity: max(capacity, 1),
+		items:    make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// LRUCache is a fixed capacity cache that evicts the least recently used
+// item when full. It is safe for concurrent use.
+type LRUCache[K comparable, V any] struct {
+	// capacity is the maximum number of items stored.
+	capacity int
+	// items maps keys to their element in the eviction order list.
+	items map[K]*list.Element
+	// order stores entries from most (front) to least (back) recently used.
+	order *list.List
+	// mu protects concurrent access to items/order.
+	mu sync.Mutex
+}
+
+// lruEntry is a key/value pair stored in the eviction order list.
+type lruEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// Get returns the value for the key and marks it as most recently used.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return *new(V), false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry[K, V]).value, true
+}
+
+// Put stores the value for the key and marks it as most recently used.
+//
+// If the cache is full, the least recently used item is evicted.
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruEntry[K, V]).value = value
+		c.order.MoveToFront(elem)
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*lruEntry[K, V]).key)
+	}
+	c.items[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value})
+}
+
+// Delete removes the key from the cache.
+func (c *LRUCache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		c.order.Remove(elem)
+		delete(c.items, key)
+	}
+}
+
+// Len returns the number of items in the cache.
+func (c *LRUCache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// Keys returns a slice of all keys ordered from most to least recently used.
+func (c *LRUCache[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]K, 0, c.order.Len())
+	for elem := c.order.Front(); elem != nil; elem = elem.Next() {
+		keys = append(keys, elem.Value.(*lruEntry[K, V]).key)
+	}
+	return keys
+}
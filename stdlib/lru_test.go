@@ -0,0 +1,71 @@
+package stdlib_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestLRUCache_Evict(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	c := stdlib.NewLRUCache[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	_, _ = c.Get("a")
+	c.Put("c", 3)
+
+	_, ok := c.Get("b")
+	test.False(ok, "least recently used key must be evicted")
+	test.Equal(c.Keys(), []string{"c", "a"})
+
+	c.Put("a", 10)
+	got, ok := c.Get("a")
+	test.True(ok, "key must be present")
+	test.Equal(got, 10)
+
+	c.Delete("a")
+	test.Equal(c.Len(), 1)
+}
+
+func TestLRUCache_CapacityOne(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	for _, capacity := range []int{1, 0, -1} {
+		c := stdlib.NewLRUCache[string, int](capacity)
+		c.Put("a", 1)
+		c.Put("b", 2)
+
+		_, ok := c.Get("a")
+		test.False(ok, "capacity=%d must evict the previous key", capacity)
+		got, ok := c.Get("b")
+		test.True(ok, "capacity=%d must keep the latest key", capacity)
+		test.Equal(got, 2)
+		test.Equal(c.Len(), 1)
+	}
+}
+
+func TestLRUCache_Concurrent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	const goroutines, puts = 4, 100
+
+	c := stdlib.NewLRUCache[int, int](puts / 2)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < puts; j++ {
+				c.Put(j, i)
+				_, _ = c.Get(j - 1)
+				_ = c.Keys()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	test.Equal(c.Len(), puts/2)
+}
@@ -1,5 +1,15 @@
 package stdlib
 
+import "maps"
+
+// ErrLengthMismatch is returned when attempting an operation on multiple
+// collections that must be the same length.
+var ErrLengthMismatch = Error{
+	Code:      "length_mismatch",
+	Message:   "collection lengths do not match",
+	Namespace: ErrorNamespaceDefault,
+}
+
 // MapFilter will return a new map containing only items
 // from the input map that match the predicate function.
 func MapFilter[K comparable, V any](input map[K]V, predicate KeyedPredicate[K, V]) map[K]V {
@@ -42,3 +52,202 @@ func MapValues[K comparable, V any](input map[K]V) []V {
 	}
 	return values
 }
+
+// MapZip returns a map created from the given slices of keys and values.
+//
+// If a key appears more than once, the last value is used. An error is
+// returned if the slices are not the same length.
+func MapZip[K comparable, V any](keys []K, values []V) (map[K]V, error) {
+	if len(keys) != len(values) {
+		return nil, ErrLengthMismatch.Wrapf("keys=%d values=%d", len(keys), len(values))
+	}
+	output := make(map[K]V, len(keys))
+	for i, key := range keys {
+		output[key] = values[i]
+	}
+	return output, nil
+}
+
+// MapFrequency returns the number of given maps that contain each value,
+// regardless of key.
+//
+// A value that appears multiple times within a single map is only counted once.
+func MapFrequency[K comparable, V comparable](maps ...map[K]V) map[V]int {
+	output := make(map[V]int)
+	for _, m := range maps {
+		seen := make(map[V]struct{}, len(m))
+		for _, val := range m {
+			if _, ok := seen[val]; ok {
+				continue
+			}
+			seen[val] = struct{}{}
+			output[val]++
+		}
+	}
+	return output
+}
+
+// MapUpdate stores the result of calling fn with the current value for the
+// key, and whether it exists, and returns the map.
+//
+// Returning the zero value from fn stores it rather than deleting the key.
+// If the given map is nil, a new map is allocated and returned.
+func MapUpdate[K comparable, V any](m map[K]V, key K, fn func(existing V, exists bool) V) map[K]V {
+	if m == nil {
+		m = make(map[K]V)
+	}
+	existing, exists := m[key]
+	m[key] = fn(existing, exists)
+	return m
+}
+
+// MapDiff returns the entries that were added, removed or changed between
+// the before and after maps.
+//
+// Changed entries map the key to the [before, after] pair of values. Each
+// result is nil if there are no entries of that kind.
+func MapDiff[K comparable, V comparable](before, after map[K]V) (added map[K]V, removed map[K]V, changed map[K][2]V) {
+	for key, val := range after {
+		prev, ok := before[key]
+		switch {
+		case !ok:
+			if added == nil {
+				added = make(map[K]V)
+			}
+			added[key] = val
+		case prev != val:
+			if changed == nil {
+				changed = make(map[K][2]V)
+			}
+			changed[key] = [2]V{prev, val}
+		}
+	}
+	for key, val := range before {
+		if _, ok := after[key]; !ok {
+			if removed == nil {
+				removed = make(map[K]V)
+			}
+			removed[key] = val
+		}
+	}
+	return added, removed, changed
+}
+
+// MapIntersect returns a new map containing only entries whose key
+// exists in both maps with the same value.
+func MapIntersect[K comparable, V comparable](a, b map[K]V) map[K]V {
+	output := make(map[K]V)
+	for key, val := range a {
+		if other, ok := b[key]; ok && other == val {
+			output[key] = val
+		}
+	}
+	return output
+}
+
+// MapIntersectKeys returns a new map containing only entries whose key
+// exists in both maps, using the values from a.
+func MapIntersectKeys[K comparable, V any](a, b map[K]V) map[K]V {
+	output := make(map[K]V)
+	for key, val := range a {
+		if _, ok := b[key]; ok {
+			output[key] = val
+		}
+	}
+	return output
+}
+
+// MapUpsert stores and returns the result of calling create if the key
+// is absent or update with the existing value if present.
+//
+// Like any map write, this panics if the given map is nil and is not
+// safe for concurrent use.
+func MapUpsert[K comparable, V any](m map[K]V, key K, create func() V, update func(existing V) V) V {
+	val, ok := m[key]
+	if ok {
+		val = update(val)
+	} else {
+		val = create()
+	}
+	m[key] = val
+	return val
+}
+
+// MapOf returns a map created from the given alternating key/value pairs.
+//
+// An error is returned if there are an odd number of pairs or if a key
+// or value is not of the expected type.
+func MapOf[K comparable, V any](pairs ...any) (map[K]V, error) {
+	if len(pairs)%2 != 0 {
+		return nil, ErrLengthMismatch.Wrapf("pairs=%d must be key/value pairs", len(pairs))
+	}
+	output := make(map[K]V, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, err := As[K](pairs[i])
+		if err != nil {
+			return nil, err
+		}
+		val, err := As[V](pairs[i+1])
+		if err != nil {
+			return nil, err
+		}
+		output[key] = val
+	}
+	return output, nil
+}
+
+// MapPickOrDefault returns a new map containing all keys from defaults, using
+// the value from m if present and the default value otherwise.
+//
+// If defaults is nil, a copy of m is returned.
+func MapPickOrDefault[K comparable, V any](m map[K]V, defaults map[K]V) map[K]V {
+	if defaults == nil {
+		return maps.Clone(m)
+	}
+	output := make(map[K]V, len(defaults))
+	for key, def := range defaults {
+		if val, ok := m[key]; ok {
+			output[key] = val
+		} else {
+			output[key] = def
+		}
+	}
+	return output
+}
+
+// MapCompact returns a new map with all entries whose value equals
+// the zero value of the type removed.
+func MapCompact[K comparable, V comparable](m map[K]V) map[K]V {
+	var zero V
+	return MapFilter(m, func(_ K, val V) bool {
+		return val != zero
+	})
+}
+
+// MapCompactZeroer returns a new map with all zero value entries removed,
+// as determined by their IsZero method.
+func MapCompactZeroer[K comparable, V Zeroer](m map[K]V) map[K]V {
+	return MapFilter(m, func(_ K, val V) bool {
+		return !val.IsZero()
+	})
+}
+
+// SliceMapKeys returns a slice with the results from the given 'map' function
+// applied to all keys of the map.
+func SliceMapKeys[K comparable, V any, T any](input map[K]V, mapper Mapper[K, T]) []T {
+	output := make([]T, 0, len(input))
+	for k := range input {
+		output = append(output, mapper(k))
+	}
+	return output
+}
+
+// SliceMapValues returns a slice with the results from the given 'map' function
+// applied to all values of the map.
+func SliceMapValues[K comparable, V any, T any](input map[K]V, mapper Mapper[V, T]) []T {
+	output := make([]T, 0, len(input))
+	for _, v := range input {
+		output = append(output, mapper(v))
+	}
+	return output
+}
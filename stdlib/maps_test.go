@@ -0,0 +1,339 @@
+package stdlib_test
+
+import (
+	"maps"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestMapZip(t *testing.T) {
+	type args struct {
+		keys   []string
+		values []int
+	}
+
+	stdtest.Table[args, map[string]int]{
+		"equal lengths": {
+			Got:  args{keys: []string{"a", "b"}, values: []int{1, 2}},
+			Want: map[string]int{"a": 1, "b": 2},
+		},
+		"empty": {
+			Got:  args{},
+			Want: map[string]int{},
+		},
+		"duplicate keys use last value": {
+			Got:  args{keys: []string{"a", "b", "a"}, values: []int{1, 2, 3}},
+			Want: map[string]int{"a": 3, "b": 2},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, map[string]int]) {
+		got, err := stdlib.MapZip(tc.Got.keys, tc.Got.values)
+		t.OK(err)
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestMapZip_LengthMismatch(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	got, err := stdlib.MapZip([]string{"a", "b"}, []int{1})
+	test.EqualError(err, stdlib.ErrLengthMismatch)
+	test.Equal(got, map[string]int(nil))
+}
+
+func TestMapFrequency(t *testing.T) {
+	stdtest.Table[[]map[string]int, map[int]int]{
+		"none": {
+			Got:  nil,
+			Want: map[int]int{},
+		},
+		"single map": {
+			Got:  []map[string]int{{"a": 1, "b": 1, "c": 2}},
+			Want: map[int]int{1: 1, 2: 1},
+		},
+		"same values": {
+			Got:  []map[string]int{{"a": 1}, {"b": 1}, {"c": 1}},
+			Want: map[int]int{1: 3},
+		},
+		"all different": {
+			Got:  []map[string]int{{"a": 1}, {"a": 2}, {"a": 3}},
+			Want: map[int]int{1: 1, 2: 1, 3: 1},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]map[string]int, map[int]int]) {
+		t.Equal(stdlib.MapFrequency(tc.Got...), tc.Want)
+	})
+}
+
+func TestMapUpdate(t *testing.T) {
+	increment := func(existing int, exists bool) int {
+		if !exists {
+			return 100
+		}
+		return existing + 1
+	}
+
+	type args struct {
+		m   map[string]int
+		key string
+	}
+
+	stdtest.Table[args, map[string]int]{
+		"update": {
+			Got:  args{m: map[string]int{"a": 1}, key: "a"},
+			Want: map[string]int{"a": 2},
+		},
+		"insert": {
+			Got:  args{m: map[string]int{"a": 1}, key: "b"},
+			Want: map[string]int{"a": 1, "b": 100},
+		},
+		"nil map": {
+			Got:  args{key: "a"},
+			Want: map[string]int{"a": 100},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, map[string]int]) {
+		t.Equal(stdlib.MapUpdate(tc.Got.m, tc.Got.key, increment), tc.Want)
+	})
+}
+
+func TestMapUpdate_ZeroDoesNotDelete(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	m := map[string]int{"a": 1}
+	got := stdlib.MapUpdate(m, "a", func(int, bool) int { return 0 })
+
+	val, ok := got["a"]
+	test.True(ok, "key must not be deleted")
+	test.Equal(val, 0)
+	test.Equal(m, got)
+}
+
+func TestMapDiff(t *testing.T) {
+	type args struct {
+		before map[string]int
+		after  map[string]int
+	}
+	type want struct {
+		added   map[string]int
+		removed map[string]int
+		changed map[string][2]int
+	}
+
+	stdtest.Table[args, want]{
+		"empty maps": {
+			Got:  args{},
+			Want: want{},
+		},
+		"identical": {
+			Got:  args{before: map[string]int{"a": 1, "b": 2}, after: map[string]int{"a": 1, "b": 2}},
+			Want: want{},
+		},
+		"all changes": {
+			Got: args{
+				before: map[string]int{"a": 1, "b": 2, "c": 3},
+				after:  map[string]int{"a": 1, "b": 20, "d": 4},
+			},
+			Want: want{
+				added:   map[string]int{"d": 4},
+				removed: map[string]int{"c": 3},
+				changed: map[string][2]int{"b": {2, 20}},
+			},
+		},
+		"nil before": {
+			Got:  args{after: map[string]int{"a": 1}},
+			Want: want{added: map[string]int{"a": 1}},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, want]) {
+		added, removed, changed := stdlib.MapDiff(tc.Got.before, tc.Got.after)
+		t.Equal(want{added: added, removed: removed, changed: changed}, tc.Want)
+	})
+}
+
+func TestMapIntersect(t *testing.T) {
+	type args struct {
+		a map[string]int
+		b map[string]int
+	}
+	type want struct {
+		intersect map[string]int
+		keys      map[string]int
+	}
+
+	stdtest.Table[args, want]{
+		"disjoint": {
+			Got:  args{a: map[string]int{"a": 1}, b: map[string]int{"b": 1}},
+			Want: want{intersect: map[string]int{}, keys: map[string]int{}},
+		},
+		"identical": {
+			Got:  args{a: map[string]int{"a": 1, "b": 2}, b: map[string]int{"a": 1, "b": 2}},
+			Want: want{intersect: map[string]int{"a": 1, "b": 2}, keys: map[string]int{"a": 1, "b": 2}},
+		},
+		"partial overlap": {
+			Got:  args{a: map[string]int{"a": 1, "b": 2, "c": 3}, b: map[string]int{"a": 1, "b": 20, "d": 4}},
+			Want: want{intersect: map[string]int{"a": 1}, keys: map[string]int{"a": 1, "b": 2}},
+		},
+		"nil maps": {
+			Got:  args{a: map[string]int{"a": 1}},
+			Want: want{intersect: map[string]int{}, keys: map[string]int{}},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, want]) {
+		t.Equal(stdlib.MapIntersect(tc.Got.a, tc.Got.b), tc.Want.intersect)
+		t.Equal(stdlib.MapIntersectKeys(tc.Got.a, tc.Got.b), tc.Want.keys)
+		t.Equal(stdlib.MapIntersect(tc.Got.b, tc.Got.a), tc.Want.intersect)
+	})
+}
+
+func TestMapUpsert(t *testing.T) {
+	create := func() []string { return []string{"created"} }
+	update := func(existing []string) []string { return append(existing, "updated") }
+
+	stdtest.Table[map[string][]string, map[string][]string]{
+		"insert": {
+			Got:  map[string][]string{"b": {"b"}},
+			Want: map[string][]string{"a": {"created"}, "b": {"b"}},
+		},
+		"update": {
+			Got:  map[string][]string{"a": {"a"}},
+			Want: map[string][]string{"a": {"a", "updated"}},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[map[string][]string, map[string][]string]) {
+		got := stdlib.MapUpsert(tc.Got, "a", create, update)
+		t.Equal(tc.Got, tc.Want)
+		t.Equal(got, tc.Want["a"])
+	})
+}
+
+func TestMapUpsert_NilMap(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var m map[string]int
+	test.Panic(func() {
+		stdlib.MapUpsert(m, "a", func() int { return 1 }, func(i int) int { return i + 1 })
+	})
+}
+
+func TestMapOf(t *testing.T) {
+	stdtest.Table[[]any, map[string]int]{
+		"valid": {
+			Got:  []any{"a", 1, "b", 2},
+			Want: map[string]int{"a": 1, "b": 2},
+		},
+		"duplicate key uses last value": {
+			Got:  []any{"a", 1, "a", 2},
+			Want: map[string]int{"a": 2},
+		},
+		"empty": {
+			Got:  nil,
+			Want: map[string]int{},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]any, map[string]int]) {
+		got, err := stdlib.MapOf[string, int](tc.Got...)
+		t.OK(err)
+		t.Equal(got, tc.Want)
+		t.Equal(stdlib.MustMapOf[string, int](tc.Got...), tc.Want)
+	})
+}
+
+func TestMapOf_Invalid(t *testing.T) {
+	stdtest.Table[[]any, error]{
+		"odd argument count": {Got: []any{"a", 1, "b"}, Want: stdlib.ErrLengthMismatch},
+		"wrong key type":     {Got: []any{1, 1}, Want: stdlib.ErrTypeAssertionFailed},
+		"wrong value type":   {Got: []any{"a", "1"}, Want: stdlib.ErrTypeAssertionFailed},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]any, error]) {
+		got, err := stdlib.MapOf[string, int](tc.Got...)
+		t.EqualError(err, tc.Want)
+		t.Equal(got, map[string]int(nil))
+		t.Panic(func() { stdlib.MustMapOf[string, int](tc.Got...) })
+	})
+}
+
+func TestMapPickOrDefault(t *testing.T) {
+	type args struct {
+		m        map[string]int
+		defaults map[string]int
+	}
+
+	stdtest.Table[args, map[string]int]{
+		"all present": {
+			Got:  args{m: map[string]int{"a": 1, "b": 2}, defaults: map[string]int{"a": 0, "b": 0}},
+			Want: map[string]int{"a": 1, "b": 2},
+		},
+		"all absent": {
+			Got:  args{m: map[string]int{"z": 26}, defaults: map[string]int{"a": 0, "b": 0}},
+			Want: map[string]int{"a": 0, "b": 0},
+		},
+		"partial": {
+			Got:  args{m: map[string]int{"a": 1, "b": 2}, defaults: map[string]int{"a": 0, "c": 3}},
+			Want: map[string]int{"a": 1, "c": 3},
+		},
+		"nil map uses defaults": {
+			Got:  args{defaults: map[string]int{"a": 0}},
+			Want: map[string]int{"a": 0},
+		},
+		"nil defaults copies map": {
+			Got:  args{m: map[string]int{"a": 1}},
+			Want: map[string]int{"a": 1},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, map[string]int]) {
+		got := stdlib.MapPickOrDefault(tc.Got.m, tc.Got.defaults)
+		t.Equal(got, tc.Want)
+
+		got["z"] = -1
+		t.NotEqual(tc.Got.m["z"], -1)
+		t.NotEqual(tc.Got.defaults["z"], -1)
+	})
+}
+
+func TestSliceMapKeys(t *testing.T) {
+	stdtest.Table[map[int]string, []string]{
+		"nil":   {Got: nil, Want: []string{}},
+		"empty": {Got: map[int]string{}, Want: []string{}},
+		"keys":  {Got: map[int]string{1: "a", 2: "b", 3: "c"}, Want: []string{"1", "2", "3"}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[map[int]string, []string]) {
+		got := stdlib.SliceMapKeys(tc.Got, strconv.Itoa)
+		sort.Strings(got)
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestSliceMapValues(t *testing.T) {
+	stdtest.Table[map[string]int, []string]{
+		"nil":    {Got: nil, Want: []string{}},
+		"empty":  {Got: map[string]int{}, Want: []string{}},
+		"values": {Got: map[string]int{"a": 1, "b": 2, "c": 3}, Want: []string{"1", "2", "3"}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[map[string]int, []string]) {
+		got := stdlib.SliceMapValues(tc.Got, strconv.Itoa)
+		sort.Strings(got)
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestMapCompact(t *testing.T) {
+	stdtest.Table[map[string]int, map[string]int]{
+		"nil":      {Got: nil, Want: map[string]int{}},
+		"empty":    {Got: map[string]int{}, Want: map[string]int{}},
+		"all zero": {Got: map[string]int{"a": 0, "b": 0}, Want: map[string]int{}},
+		"mixed":    {Got: map[string]int{"a": 0, "b": 2, "c": 0, "d": 4}, Want: map[string]int{"b": 2, "d": 4}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[map[string]int, map[string]int]) {
+		original := maps.Clone(tc.Got)
+		t.Equal(stdlib.MapCompact(tc.Got), tc.Want)
+		t.Equal(tc.Got, original)
+	})
+}
+
+func TestMapCompactZeroer(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	input := map[string]stdlib.Error{"a": errA, "zero": {}, "b": errB}
+	test.Equal(stdlib.MapCompactZeroer(input), map[string]stdlib.Error{"a": errA, "b": errB})
+	test.Equal(len(input), 3)
+	test.Equal(stdlib.MapCompactZeroer(map[string]stdlib.Error{"zero": {}}), map[string]stdlib.Error{})
+}
+
+func TestMapPickOrDefault_Nil(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.MapPickOrDefault[string, int](nil, nil), map[string]int(nil))
+}
@@ -22,3 +22,50 @@ func (m *Memoize[T]) Get() (T, error) {
 	})
 	return m.res, m.err
 }
+
+// Once is a simple struct that wraps a `sync.Once` to provide
+// thread safe lazy initialization of a typed value.
+type Once[T any] struct {
+	// res stores result of the initialization.
+	res T
+	// once is used to ensure initialization is only performed one time.
+	once sync.Once
+}
+
+// Do calls fn the first time it is called and returns its cached result
+// on this and all future calls.
+func (o *Once[T]) Do(fn func() T) T {
+	o.once.Do(func() {
+		o.res = fn()
+	})
+	return o.res
+}
+
+// Lazy provides thread safe lazy initialization of a typed value
+// where the initialization can fail.
+type Lazy[T any] struct {
+	// RetryOnError calls init again on the next Get if the
+	// previous initialization failed instead of caching the error.
+	RetryOnError bool
+	// res stores result of the initialization.
+	res T
+	// err stores error if the initialization failed.
+	err error
+	// done is true once a result has been cached.
+	done bool
+	// mu protects concurrent initialization.
+	mu sync.Mutex
+}
+
+// Get calls init on first access and returns its cached result/error
+// on this and all future calls.
+func (l *Lazy[T]) Get(init func() (T, error)) (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.done {
+		l.res, l.err = init()
+		l.done = l.err == nil || !l.RetryOnError
+	}
+	return l.res, l.err
+}
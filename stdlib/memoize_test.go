@@ -0,0 +1,83 @@
+package stdlib_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestOnce(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var once stdlib.Once[string]
+	test.Equal(once.Do(func() string { return "first" }), "first")
+	test.Equal(once.Do(func() string { return "second" }), "first")
+}
+
+func TestOnce_Concurrent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var once stdlib.Once[int]
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	got := make([]int, 8)
+	for i := range got {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i] = once.Do(func() int { return int(calls.Add(1)) })
+		}(i)
+	}
+	wg.Wait()
+
+	test.Equal(got, []int{1, 1, 1, 1, 1, 1, 1, 1})
+	test.Equal(calls.Load(), int32(1))
+}
+
+func TestLazy_Get(t *testing.T) {
+	errInit := errors.New("init failed")
+
+	stdtest.Table[bool, int32]{
+		"caches error":     {Got: false, Want: 1},
+		"retries on error": {Got: true, Want: 2},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[bool, int32]) {
+		lazy := stdlib.Lazy[int]{RetryOnError: tc.Got}
+		var calls atomic.Int32
+		initFn := func() (int, error) {
+			if calls.Add(1) == 1 {
+				return 0, errInit
+			}
+			return 42, nil
+		}
+
+		_, err := lazy.Get(initFn)
+		t.EqualError(err, errInit)
+		_, _ = lazy.Get(initFn)
+		_, _ = lazy.Get(initFn)
+		t.Equal(calls.Load(), tc.Want)
+	})
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var lazy stdlib.Lazy[int]
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	got := make([]int, 8)
+	for i := range got {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i], _ = lazy.Get(func() (int, error) { return int(calls.Add(1)), nil })
+		}(i)
+	}
+	wg.Wait()
+
+	test.Equal(got, []int{1, 1, 1, 1, 1, 1, 1, 1})
+	test.Equal(calls.Load(), int32(1))
+}
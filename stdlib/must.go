@@ -33,6 +33,31 @@ func MustE[T any](fn func() (T, error)) T {
 	return Must[T](t)
 }
 
+// SliceMust panics if the given slice is empty.
+func SliceMust[T any](input []T) []T {
+	if len(input) == 0 {
+		panic(fmt.Sprintf("SliceMust[%s] received empty slice", reflect.TypeFor[T]()))
+	}
+	return input
+}
+
+// SliceMustLen panics if the given slice does not have length n.
+func SliceMustLen[T any](input []T, n int) []T {
+	if len(input) != n {
+		panic(fmt.Sprintf("SliceMustLen[%s] received slice of length %d, expected %d", reflect.TypeFor[T](), len(input), n))
+	}
+	return input
+}
+
+// MapMustGet returns the value for the given key and panics if it does not exist.
+func MapMustGet[K comparable, V any](m map[K]V, key K) V {
+	v, ok := m[key]
+	if !ok {
+		panic(fmt.Sprintf("MapMustGet[%s, %s] missing key %v", reflect.TypeFor[K](), reflect.TypeFor[V](), key))
+	}
+	return v
+}
+
 // MustMapAny returns the map[string]any of the given value and panics if it cannot.
 func MustMapAny[T any](value T) map[string]any {
 	v, err := ToMapAny[T](value)
@@ -42,6 +67,15 @@ func MustMapAny[T any](value T) map[string]any {
 	return v
 }
 
+// MustMapOf returns the map created from the given key/value pairs and panics if it cannot.
+func MustMapOf[K comparable, V any](pairs ...any) map[K]V {
+	v, err := MapOf[K, V](pairs...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 // MustMapString returns the map[string]string of the given value and panics if it cannot.
 func MustMapString[T any](value T) map[string]string {
 	v, err := ToMapString[T](value)
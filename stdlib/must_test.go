@@ -0,0 +1,41 @@
+package stdlib_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestSliceMust(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.SliceMust([]int{1, 2}), []int{1, 2})
+	test.Panic(func() { stdlib.SliceMust([]int{}) })
+	test.Panic(func() { stdlib.SliceMust[string](nil) })
+}
+
+func TestSliceMustLen(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.SliceMustLen([]int{1, 2}, 2), []int{1, 2})
+	test.Equal(stdlib.SliceMustLen([]int(nil), 0), []int(nil))
+	test.Panic(func() { stdlib.SliceMustLen([]int{1, 2}, 3) })
+	test.Panic(func() { stdlib.SliceMustLen([]int{1, 2}, 1) })
+}
+
+func TestMapMustGet(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	m := map[string]int{"a": 1}
+	test.Equal(stdlib.MapMustGet(m, "a"), 1)
+
+	defer func() {
+		r := recover()
+		test.True(r != nil, "missing key must panic")
+		test.True(strings.Contains(fmt.Sprint(r), "missing-key"), "panic must contain the key: %v", r)
+	}()
+	stdlib.MapMustGet(m, "missing-key")
+}
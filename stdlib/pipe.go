@@ -0,0 +1,11 @@
+package stdlib
+
+// Pipe returns the result of passing the value through all given
+// functions in order (left-to-right), using the output of each as
+// the input of the next.
+func Pipe[T any](v T, fns ...func(t T) T) T {
+	for _, fn := range fns {
+		v = fn(v)
+	}
+	return v
+}
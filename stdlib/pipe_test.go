@@ -0,0 +1,22 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestPipe(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	inc := func(i int) int { return i + 1 }
+
+	stdtest.Table[[]func(int) int, int]{
+		"none":  {Got: nil, Want: 3},
+		"one":   {Got: []func(int) int{double}, Want: 6},
+		"three": {Got: []func(int) int{double, inc, double}, Want: 14},
+		"order": {Got: []func(int) int{inc, double, double}, Want: 16},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]func(int) int, int]) {
+		t.Equal(stdlib.Pipe(3, tc.Got...), tc.Want)
+	})
+}
@@ -6,3 +6,11 @@ type Predicate[T any] func(t T) bool
 // KeyedPredicate describes functions which return true/false based on a given
 // key/value input.
 type KeyedPredicate[K comparable, V any] func(k K, v V) bool
+
+// PredicateFromError returns a Predicate that is true when the given
+// error-returning function returns nil.
+func PredicateFromError[T any](fn func(t T) error) Predicate[T] {
+	return func(t T) bool {
+		return fn(t) == nil
+	}
+}
@@ -0,0 +1,23 @@
+package stdlib_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestPredicateFromError(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	positive := stdlib.PredicateFromError(func(i int) error {
+		if i <= 0 {
+			return fmt.Errorf("%d is not positive", i)
+		}
+		return nil
+	})
+	test.True(positive(1), "nil error must be true")
+	test.False(positive(0), "non-nil error must be false")
+	test.Equal(stdlib.SliceFilter([]int{-1, 2, 0, 3}, positive), []int{2, 3})
+}
@@ -0,0 +1,79 @@
+package stdlib
+
+// queueMinCapacity is the initial capacity of the Queue ring buffer.
+const queueMinCapacity = 8
+
+// NewQueue creates a new *Queue with the given items enqueued in order.
+func NewQueue[T any](items ...T) *Queue[T] {
+	q := &Queue[T]{}
+	for _, item := range items {
+		q.Enqueue(item)
+	}
+	return q
+}
+
+// Queue is a first-in-first-out (FIFO) collection backed by a ring buffer.
+//
+// It is not safe for concurrent use without external synchronization.
+type Queue[T any] struct {
+	// items is the ring buffer storing the queue items.
+	items []T
+	// head is the index of the item at the front of the queue.
+	head int
+	// size is the number of items in the queue.
+	size int
+}
+
+// Enqueue adds the item to the back of the queue.
+func (q *Queue[T]) Enqueue(t T) {
+	if q.size == len(q.items) {
+		q.grow()
+	}
+	q.items[(q.head+q.size)%len(q.items)] = t
+	q.size++
+}
+
+// Dequeue removes and returns the item from the front of the queue.
+//
+// If the queue is empty, the zero value and false are returned.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	t, ok := q.Front()
+	if !ok {
+		return t, false
+	}
+	q.items[q.head] = *new(T)
+	q.head = (q.head + 1) % len(q.items)
+	q.size--
+	return t, true
+}
+
+// Front returns the item from the front of the queue without removing it.
+//
+// If the queue is empty, the zero value and false are returned.
+func (q *Queue[T]) Front() (T, bool) {
+	if q.IsEmpty() {
+		return *new(T), false
+	}
+	return q.items[q.head], true
+}
+
+// Len returns the number of items in the queue.
+func (q *Queue[T]) Len() int {
+	return q.size
+}
+
+// IsEmpty returns true if the queue has no items.
+func (q *Queue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// grow doubles the capacity of the ring buffer, unwrapping
+// items so the front of the queue is at index zero.
+func (q *Queue[T]) grow() {
+	items := make([]T, max(len(q.items)*2, queueMinCapacity))
+	for i := 0; i < q.size; i++ {
+		items[i] = q.items[(q.head+i)%len(q.items)]
+	}
+	q.items = items
+	q.head = 0
+}
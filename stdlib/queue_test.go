@@ -0,0 +1,90 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestQueue(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	q := stdlib.NewQueue(1, 2)
+	q.Enqueue(3)
+	test.Equal(q.Len(), 3)
+
+	front, ok := q.Front()
+	test.True(ok, "non-empty queue must have a front")
+	test.Equal(front, 1)
+	test.Equal(q.Len(), 3)
+
+	var got []int
+	for !q.IsEmpty() {
+		item, ok := q.Dequeue()
+		test.True(ok, "non-empty queue must dequeue")
+		got = append(got, item)
+	}
+	test.Equal(got, []int{1, 2, 3})
+}
+
+func TestQueue_Empty(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var q stdlib.Queue[string]
+	test.True(q.IsEmpty(), "zero value queue must be empty")
+
+	item, ok := q.Dequeue()
+	test.False(ok, "empty queue must not dequeue")
+	test.Equal(item, "")
+
+	item, ok = q.Front()
+	test.False(ok, "empty queue must not have a front")
+	test.Equal(item, "")
+}
+
+func TestQueue_Grow(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var q stdlib.Queue[int]
+	for i := 0; i < 100; i++ {
+		q.Enqueue(i)
+	}
+	test.Equal(q.Len(), 100)
+
+	for i := 0; i < 100; i++ {
+		item, _ := q.Dequeue()
+		test.Equal(item, i)
+	}
+	test.True(q.IsEmpty(), "queue must be empty")
+}
+
+func TestQueue_WrapAround(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	// Advance the head so new items wrap to the start of the ring buffer
+	// before it has to grow.
+	q := stdlib.NewQueue(0, 1, 2, 3, 4, 5)
+	var got []int
+	for i := 0; i < 4; i++ {
+		item, _ := q.Dequeue()
+		got = append(got, item)
+	}
+	for i := 6; i < 20; i++ {
+		q.Enqueue(i)
+		if i%3 == 0 {
+			item, _ := q.Dequeue()
+			got = append(got, item)
+		}
+	}
+	for !q.IsEmpty() {
+		item, _ := q.Dequeue()
+		got = append(got, item)
+	}
+
+	want := make([]int, 20)
+	for i := range want {
+		want[i] = i
+	}
+	test.Equal(got, want)
+}
@@ -0,0 +1,61 @@
+package stdlib
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// RetryConfig defines how a failed operation should be retried.
+type RetryConfig struct {
+	// RetryExtras contains the delay/backoff between attempts and the
+	// maximum number of attempts. Values less than one are treated as
+	// a single attempt.
+	RetryExtras
+	// Jitter is the maximum random duration added to each delay.
+	Jitter time.Duration
+}
+
+// IsRetryableError returns true if the given error is an Error
+// that indicates the failed operation is safe to retry.
+func IsRetryableError(err error) bool {
+	var e Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.IsRetryable()
+}
+
+// Retry calls the given function until it succeeds, returns an error that
+// is not retryable, the maximum number of attempts is reached or the context
+// is cancelled.
+//
+// Attempts are numbered starting from one. When all attempts fail, the error
+// from the last attempt is returned.
+func Retry[T any](ctx context.Context, cfg RetryConfig, fn func(ctx context.Context, attempt int) (T, error)) (T, error) {
+	maxAttempts := max(cfg.MaxAttempts, 1)
+
+	for attempt := 1; ; attempt++ {
+		t, err := fn(ctx, attempt)
+		if err == nil {
+			return t, nil
+		}
+		if attempt >= maxAttempts || !IsRetryableError(err) {
+			return t, err
+		}
+
+		delay := cfg.ComputeDelay(attempt - 1)
+		if cfg.Jitter > 0 {
+			delay += time.Duration(rand.Int63n(int64(cfg.Jitter))) //nolint:gosec
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return *new(T), ErrorJoin(err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package stdlib_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+// retryConfig returns a RetryConfig with a short delay and the given maximum attempts.
+func retryConfig(maxAttempts int) stdlib.RetryConfig {
+	return stdlib.RetryConfig{
+		RetryExtras: stdlib.RetryExtras{Delay: time.Millisecond, MaxAttempts: maxAttempts},
+	}
+}
+
+func TestRetry(t *testing.T) {
+	retryable := errA.WithFlag(stdlib.ErrorFlagRetryable)
+
+	type want struct {
+		value    int
+		attempts int
+		err      error
+	}
+
+	stdtest.Table[int, want]{
+		"first attempt succeeds": {
+			Got:  1,
+			Want: want{value: 1, attempts: 1},
+		},
+		"third attempt succeeds": {
+			Got:  3,
+			Want: want{value: 3, attempts: 3},
+		},
+		"max attempts exhausted": {
+			Got:  5,
+			Want: want{attempts: 3, err: retryable},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[int, want]) {
+		attempts := 0
+		got, err := stdlib.Retry(context.Background(), retryConfig(3), func(_ context.Context, attempt int) (int, error) {
+			attempts++
+			if attempt < tc.Got {
+				return 0, retryable.Wrapf("attempt %d", attempt)
+			}
+			return attempt, nil
+		})
+		t.Equal(got, tc.Want.value)
+		t.Equal(attempts, tc.Want.attempts)
+		if tc.Want.err == nil {
+			t.OK(err)
+			return
+		}
+		t.EqualError(err, tc.Want.err)
+		t.Equal(errors.Unwrap(err).Error(), "attempt 3")
+	})
+}
+
+func TestRetry_NotRetryable(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	attempts := 0
+	_, err := stdlib.Retry(context.Background(), retryConfig(3), func(context.Context, int) (int, error) {
+		attempts++
+		return 0, errA
+	})
+	test.EqualError(err, errA)
+	test.Equal(attempts, 1)
+}
+
+func TestRetry_Cancel(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	retryable := errA.WithFlag(stdlib.ErrorFlagRetryable)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	attempts := 0
+	cfg := retryConfig(3)
+	cfg.Delay = time.Hour
+	_, err := stdlib.Retry(ctx, cfg, func(context.Context, int) (int, error) {
+		attempts++
+		cancel()
+		return 0, retryable
+	})
+	test.Equal(attempts, 1)
+	test.True(errors.Is(err, retryable), "error must include the last attempt error: %v", err)
+	test.True(errors.Is(err, context.Canceled), "error must include the context error: %v", err)
+}
+
+func TestRetry_Permanent(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	err := errA.WithFlag(stdlib.ErrorFlagRetryable | stdlib.ErrorFlagPermanent)
+	test.False(err.IsRetryable(), "permanent error must not be retryable")
+	test.False(stdlib.IsRetryableError(err), "permanent error must not be retryable")
+
+	attempts := 0
+	_, got := stdlib.Retry(context.Background(), retryConfig(3), func(context.Context, int) (int, error) {
+		attempts++
+		return 0, err
+	})
+	test.EqualError(got, err)
+	test.Equal(attempts, 1)
+}
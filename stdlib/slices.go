@@ -1,5 +1,20 @@
 package stdlib
 
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// ErrIndexOutOfRange is returned when attempting to access a slice
+// with an index outside its bounds.
+var ErrIndexOutOfRange = Error{
+	Code:      "index_out_of_range",
+	Message:   "index out of range",
+	Namespace: ErrorNamespaceDefault,
+}
+
 // SliceFlatten will flatten a slice of slices into a
 // single slice.
 func SliceFlatten[T any](input ...[]T) []T {
@@ -47,6 +62,18 @@ func SliceToMap[K comparable, V any](input []V, key func(v V) K) map[K]V {
 	return output
 }
 
+// SliceToMapFunc returns a map from the given slice using the key and value
+// functions for each item.
+//
+// If multiple items have the same key, the last one wins.
+func SliceToMapFunc[T any, K comparable, V any](input []T, keyFn func(t T) K, valueFn func(t T) V) map[K]V {
+	output := make(map[K]V, len(input))
+	for _, item := range input {
+		output[keyFn(item)] = valueFn(item)
+	}
+	return output
+}
+
 // SliceFilter will return a new slice containing only items
 // from the given input that match the predicate function.
 func SliceFilter[T any](input []T, predicate Predicate[T]) []T {
@@ -71,3 +98,303 @@ func SliceFilterRange[T any](input Ranger[T], predicate Predicate[T]) []T {
 	})
 	return output
 }
+
+// SliceGenerator returns a Ranger that lazily produces items by calling
+// the given function with increasing indices, starting at zero, until it
+// returns false.
+func SliceGenerator[T any](fn func(i int) (T, bool)) Ranger[T] {
+	return generator[T](fn)
+}
+
+// generator implements Ranger for lazily produced items.
+type generator[T any] func(i int) (T, bool)
+
+// Range calls the predicate for each generated item.
+//
+// Iteration stops when either the generator or the predicate return `false`.
+func (g generator[T]) Range(predicate Predicate[T]) {
+	for i := 0; ; i++ {
+		item, ok := g(i)
+		if !ok || !predicate(item) {
+			return
+		}
+	}
+}
+
+// SliceScanLeft returns a slice with all intermediate results of accumulating
+// the given input from left to right, starting with the initial value.
+//
+// The result has a length of len(input)+1 where the first item is the initial
+// value and the last item is the final accumulated value.
+func SliceScanLeft[T any, A any](input []T, initial A, fn func(acc A, item T) A) []A {
+	output := make([]A, 0, len(input)+1)
+	output = append(output, initial)
+	for i, item := range input {
+		output = append(output, fn(output[i], item))
+	}
+	return output
+}
+
+// SliceMinMax returns the minimum and maximum items of the given slice
+// in a single pass using the less function for comparison.
+//
+// If the slice is empty, ok is false.
+func SliceMinMax[T any](input []T, less func(a, b T) bool) (min, max T, ok bool) {
+	if len(input) == 0 {
+		return min, max, false
+	}
+	min, max = input[0], input[0]
+	for _, item := range input[1:] {
+		if less(item, min) {
+			min = item
+		}
+		if less(max, item) {
+			max = item
+		}
+	}
+	return min, max, true
+}
+
+// SliceAt returns the item at the given index and true, or the zero
+// value and false if the index is out of range.
+func SliceAt[T any](input []T, index int) (T, bool) {
+	if index < 0 || index >= len(input) {
+		return *new(T), false
+	}
+	return input[index], true
+}
+
+// SliceRemoveAt returns a new slice with the item at the given index removed.
+//
+// An error is returned if the index is out of range.
+func SliceRemoveAt[T any](input []T, index int) ([]T, error) {
+	if index < 0 || index >= len(input) {
+		return nil, ErrIndexOutOfRange.Wrapf("index=%d len=%d", index, len(input))
+	}
+	output := make([]T, 0, len(input)-1)
+	output = append(output, input[:index]...)
+	return append(output, input[index+1:]...), nil
+}
+
+// SliceInsertAt returns a new slice with the value inserted at the given index.
+//
+// Inserting at index len(input) is equivalent to append. An error is returned
+// if the index is out of range.
+func SliceInsertAt[T any](input []T, index int, value T) ([]T, error) {
+	if index < 0 || index > len(input) {
+		return nil, ErrIndexOutOfRange.Wrapf("index=%d len=%d", index, len(input))
+	}
+	output := make([]T, 0, len(input)+1)
+	output = append(output, input[:index]...)
+	output = append(output, value)
+	return append(output, input[index:]...), nil
+}
+
+// SliceSortedInsert returns a new slice with the value inserted into the given
+// slice, which must be sorted in ascending order by key.
+//
+// The position is found using a binary search; values with an equal key are
+// inserted after any existing items with that key.
+func SliceSortedInsert[T any, K constraints.Ordered](sorted []T, value T, key func(t T) K) []T {
+	k := key(value)
+	index := sort.Search(len(sorted), func(i int) bool {
+		return key(sorted[i]) > k
+	})
+	output, _ := SliceInsertAt(sorted, index, value)
+	return output
+}
+
+// SliceUpdateAt returns a new slice with the item at the given index
+// replaced by the value.
+//
+// An error is returned if the index is out of range.
+func SliceUpdateAt[T any](input []T, index int, value T) ([]T, error) {
+	if index < 0 || index >= len(input) {
+		return nil, ErrIndexOutOfRange.Wrapf("index=%d len=%d", index, len(input))
+	}
+	output := make([]T, len(input))
+	copy(output, input)
+	output[index] = value
+	return output, nil
+}
+
+// SliceCopyAndAppend returns a new slice containing the items from dst
+// followed by the given values.
+//
+// Unlike append, the result never shares a backing array with dst.
+func SliceCopyAndAppend[T any](dst []T, values ...T) []T {
+	output := make([]T, 0, len(dst)+len(values))
+	output = append(output, dst...)
+	return append(output, values...)
+}
+
+// SliceApplyAll returns a new slice where each item is the result of passing
+// the item through all given functions in order (left-to-right).
+func SliceApplyAll[T any](input []T, fns ...func(t T) T) []T {
+	output := make([]T, 0, len(input))
+	for _, item := range input {
+		output = append(output, Pipe(item, fns...))
+	}
+	return output
+}
+
+// SliceZipN returns a slice where each item is a slice containing the items
+// at the same index from each of the given slices.
+//
+// The result is the length of the shortest given slice.
+func SliceZipN[T any](slices ...[]T) [][]T {
+	if len(slices) == 0 {
+		return [][]T{}
+	}
+	length := len(slices[0])
+	for _, slice := range slices[1:] {
+		length = min(length, len(slice))
+	}
+	output := make([][]T, 0, length)
+	for i := 0; i < length; i++ {
+		items := make([]T, 0, len(slices))
+		for _, slice := range slices {
+			items = append(items, slice[i])
+		}
+		output = append(output, items)
+	}
+	return output
+}
+
+// SliceDiff returns the items in after that are not in before (added) and
+// the items in before that are not in after (removed).
+//
+// Items are compared as sets, not by position, and are returned in the
+// order they appear in their source slice.
+func SliceDiff[T comparable](before, after []T) (added, removed []T) {
+	beforeSet, afterSet := SliceSet(before), SliceSet(after)
+	added = SliceFilter(after, func(item T) bool {
+		_, ok := beforeSet[item]
+		return !ok
+	})
+	removed = SliceFilter(before, func(item T) bool {
+		_, ok := afterSet[item]
+		return !ok
+	})
+	return added, removed
+}
+
+// SliceDistinct returns a new slice containing only the first
+// occurrence of each item from the given input.
+func SliceDistinct[T comparable](input []T) []T {
+	return SliceDistinctBy(input, func(item T) T { return item })
+}
+
+// SliceDistinctBy returns a new slice containing only the first
+// occurrence of each item from the given input, as identified by
+// the key function.
+func SliceDistinctBy[T any, K comparable](input []T, key func(t T) K) []T {
+	seen := make(map[K]struct{}, len(input))
+	output := make([]T, 0, len(input))
+	for _, item := range input {
+		k := key(item)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		output = append(output, item)
+	}
+	return output
+}
+
+// SliceUnique returns a new slice containing only the first
+// occurrence of each item from the given input.
+//
+// It is equivalent to SliceDistinct.
+func SliceUnique[T comparable](input []T) []T {
+	return SliceDistinct(input)
+}
+
+// SliceFilterWithErrors will return a new slice containing only items
+// from the given input for which the function returns a nil error, along
+// with all non-nil errors returned.
+func SliceFilterWithErrors[T any](input []T, fn func(t T) error) ([]T, []error) {
+	var (
+		output []T
+		errs   []error
+	)
+	for _, item := range input {
+		if err := fn(item); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		output = append(output, item)
+	}
+	return output, errs
+}
+
+// SliceMapWithIndex returns a slice with the results from the given 'map' function
+// which also receives the index of each item.
+func SliceMapWithIndex[TIn any, TOut any](input []TIn, mapper func(i int, t TIn) TOut) []TOut {
+	output := make([]TOut, 0, len(input))
+	for i, item := range input {
+		output = append(output, mapper(i, item))
+	}
+	return output
+}
+
+// SliceMapFilter returns a slice with the results from the given function
+// for only the items where it also returns true (map + filter).
+func SliceMapFilter[TIn any, TOut any](input []TIn, fn func(t TIn) (TOut, bool)) []TOut {
+	var output []TOut
+	for _, item := range input {
+		if mapped, ok := fn(item); ok {
+			output = append(output, mapped)
+		}
+	}
+	return output
+}
+
+// SliceNth returns a new slice containing every nth item from the given
+// input, starting with the first, i.e. indices 0, n, 2n, ...
+//
+// It panics if n is less than one.
+func SliceNth[T any](input []T, n int) []T {
+	if n <= 0 {
+		panic(fmt.Sprintf("SliceNth[%T] received n=%d; must be positive", input, n))
+	}
+	output := make([]T, 0, (len(input)+n-1)/n)
+	for i := 0; i < len(input); i += n {
+		output = append(output, input[i])
+	}
+	return output
+}
+
+// SliceCountBy returns the number of items from the given input
+// for each key returned by the key function.
+func SliceCountBy[T any, K comparable](input []T, key func(t T) K) map[K]int {
+	output := make(map[K]int)
+	for _, item := range input {
+		output[key(item)]++
+	}
+	return output
+}
+
+// SliceFrequency returns how often each item appears in the given input.
+//
+// It is equivalent to SliceCountBy with the item as its own key.
+func SliceFrequency[T comparable](input []T) map[T]int {
+	return SliceCountBy(input, func(item T) T { return item })
+}
+
+// SliceCompact returns a new slice with all zero value items removed,
+// as determined by their IsZero method.
+func SliceCompact[T Zeroer](input []T) []T {
+	return SliceFilter(input, func(item T) bool {
+		return !item.IsZero()
+	})
+}
+
+// SliceCompactComparable returns a new slice with all items equal to
+// the zero value of the type removed.
+func SliceCompactComparable[T comparable](input []T) []T {
+	var zero T
+	return SliceFilter(input, func(item T) bool {
+		return item != zero
+	})
+}
@@ -0,0 +1,538 @@
+package stdlib_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestSliceGenerator(t *testing.T) {
+	squares := func(limit int) stdlib.Ranger[int] {
+		return stdlib.SliceGenerator(func(i int) (int, bool) {
+			return i * i, limit < 0 || i < limit
+		})
+	}
+
+	stdtest.Table[stdlib.Ranger[int], []int]{
+		"finite": {
+			Got:  squares(4),
+			Want: []int{0, 1, 4, 9},
+		},
+		"empty": {
+			Got:  squares(0),
+			Want: nil,
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[stdlib.Ranger[int], []int]) {
+		var got []int
+		tc.Got.Range(func(item int) bool {
+			got = append(got, item)
+			return true
+		})
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestSliceGenerator_EarlyTermination(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var calls int
+	infinite := stdlib.SliceGenerator(func(i int) (int, bool) {
+		calls++
+		return i, true
+	})
+
+	var got []int
+	infinite.Range(func(item int) bool {
+		got = append(got, item)
+		return len(got) < 5
+	})
+	test.Equal(got, []int{0, 1, 2, 3, 4})
+	test.Equal(calls, 5)
+}
+
+// sliceReduce folds the input from left to right, as a reference for SliceScanLeft.
+func sliceReduce[T any, A any](input []T, initial A, fn func(acc A, item T) A) A {
+	acc := initial
+	for _, item := range input {
+		acc = fn(acc, item)
+	}
+	return acc
+}
+
+func TestSliceScanLeft(t *testing.T) {
+	sum := func(acc int, item int) int { return acc + item }
+
+	stdtest.Table[[]int, []int]{
+		"empty":    {Got: []int{}, Want: []int{10}},
+		"single":   {Got: []int{1}, Want: []int{10, 11}},
+		"multiple": {Got: []int{1, 2, 3, 4}, Want: []int{10, 11, 13, 16, 20}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]int, []int]) {
+		got := stdlib.SliceScanLeft(tc.Got, 10, sum)
+		t.Equal(got, tc.Want)
+		t.Equal(len(got), len(tc.Got)+1)
+		t.Equal(got[len(tc.Got)], sliceReduce(tc.Got, 10, sum))
+	})
+}
+
+func TestSliceMinMax(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	large := make([]int, 1000)
+	for i := range large {
+		large[i] = (i * 7919) % 1000
+	}
+
+	stdtest.Table[[]int, [2]int]{
+		"single":       {Got: []int{5}, Want: [2]int{5, 5}},
+		"two":          {Got: []int{9, 3}, Want: [2]int{3, 9}},
+		"many":         {Got: []int{4, -2, 8, 0, 8, -2}, Want: [2]int{-2, 8}},
+		"large":        {Got: large, Want: [2]int{0, 999}},
+		"all the same": {Got: []int{1, 1, 1}, Want: [2]int{1, 1}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]int, [2]int]) {
+		minimum, maximum, ok := stdlib.SliceMinMax(tc.Got, less)
+		t.True(ok, "non-empty slice must have a min and max")
+		t.Equal([2]int{minimum, maximum}, tc.Want)
+		t.Equal(minimum, slices.Min(tc.Got))
+		t.Equal(maximum, slices.Max(tc.Got))
+	})
+}
+
+func TestSliceMinMax_Empty(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	minimum, maximum, ok := stdlib.SliceMinMax([]int{}, func(a, b int) bool { return a < b })
+	test.False(ok, "empty slice must not have a min and max")
+	test.Equal(minimum, 0)
+	test.Equal(maximum, 0)
+}
+
+func TestSliceRemoveAt(t *testing.T) {
+	type args struct {
+		input []int
+		index int
+	}
+
+	stdtest.Table[args, []int]{
+		"start":          {Got: args{input: []int{1, 2, 3}, index: 0}, Want: []int{2, 3}},
+		"middle":         {Got: args{input: []int{1, 2, 3}, index: 1}, Want: []int{1, 3}},
+		"end":            {Got: args{input: []int{1, 2, 3}, index: 2}, Want: []int{1, 2}},
+		"single element": {Got: args{input: []int{1}, index: 0}, Want: []int{}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, []int]) {
+		original := slices.Clone(tc.Got.input)
+		got, err := stdlib.SliceRemoveAt(tc.Got.input, tc.Got.index)
+		t.OK(err)
+		t.Equal(got, tc.Want)
+		t.Equal(tc.Got.input, original)
+	})
+}
+
+func TestSliceRemoveAt_OutOfRange(t *testing.T) {
+	type args struct {
+		input []int
+		index int
+	}
+
+	stdtest.Table[args, error]{
+		"empty slice":    {Got: args{input: []int{}, index: 0}, Want: stdlib.ErrIndexOutOfRange},
+		"negative index": {Got: args{input: []int{1, 2}, index: -1}, Want: stdlib.ErrIndexOutOfRange},
+		"index is len":   {Got: args{input: []int{1, 2}, index: 2}, Want: stdlib.ErrIndexOutOfRange},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, error]) {
+		got, err := stdlib.SliceRemoveAt(tc.Got.input, tc.Got.index)
+		t.EqualError(err, tc.Want)
+		t.Equal(got, []int(nil))
+	})
+}
+
+func TestSliceInsertAt(t *testing.T) {
+	type args struct {
+		input []int
+		index int
+	}
+
+	stdtest.Table[args, []int]{
+		"start":  {Got: args{input: []int{1, 2, 3}, index: 0}, Want: []int{9, 1, 2, 3}},
+		"middle": {Got: args{input: []int{1, 2, 3}, index: 1}, Want: []int{1, 9, 2, 3}},
+		"end":    {Got: args{input: []int{1, 2, 3}, index: 3}, Want: append([]int{1, 2, 3}, 9)},
+		"empty":  {Got: args{input: []int{}, index: 0}, Want: []int{9}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, []int]) {
+		original := slices.Clone(tc.Got.input)
+		got, err := stdlib.SliceInsertAt(tc.Got.input, tc.Got.index, 9)
+		t.OK(err)
+		t.Equal(got, tc.Want)
+		t.Equal(tc.Got.input, original)
+	})
+}
+
+func TestSliceInsertAt_OutOfRange(t *testing.T) {
+	type args struct {
+		input []int
+		index int
+	}
+
+	stdtest.Table[args, error]{
+		"beyond end":     {Got: args{input: []int{1, 2}, index: 3}, Want: stdlib.ErrIndexOutOfRange},
+		"negative index": {Got: args{input: []int{1, 2}, index: -1}, Want: stdlib.ErrIndexOutOfRange},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, error]) {
+		got, err := stdlib.SliceInsertAt(tc.Got.input, tc.Got.index, 9)
+		t.EqualError(err, tc.Want)
+		t.Equal(got, []int(nil))
+	})
+}
+
+func TestSliceApplyAll(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	increment := func(i int) int { return i + 1 }
+	negate := func(i int) int { return -i }
+
+	stdtest.Table[[]func(int) int, []int]{
+		"no functions":    {Got: nil, Want: []int{1, 2, 3}},
+		"one function":    {Got: []func(int) int{double}, Want: []int{2, 4, 6}},
+		"three functions": {Got: []func(int) int{double, increment, negate}, Want: []int{-3, -5, -7}},
+		"left to right":   {Got: []func(int) int{increment, double}, Want: []int{4, 6, 8}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]func(int) int, []int]) {
+		t.Equal(stdlib.SliceApplyAll([]int{1, 2, 3}, tc.Got...), tc.Want)
+	})
+}
+
+func TestSliceZipN(t *testing.T) {
+	stdtest.Table[[][]int, [][]int]{
+		"zero slices": {
+			Got:  nil,
+			Want: [][]int{},
+		},
+		"one slice": {
+			Got:  [][]int{{1, 2}},
+			Want: [][]int{{1}, {2}},
+		},
+		"two slices": {
+			Got:  [][]int{{1, 2}, {3, 4}},
+			Want: [][]int{{1, 3}, {2, 4}},
+		},
+		"three slices of different lengths": {
+			Got:  [][]int{{1, 2, 3}, {4, 5}, {6, 7, 8, 9}},
+			Want: [][]int{{1, 4, 6}, {2, 5, 7}},
+		},
+		"empty slice": {
+			Got:  [][]int{{1, 2}, {}},
+			Want: [][]int{},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[][]int, [][]int]) {
+		t.Equal(stdlib.SliceZipN(tc.Got...), tc.Want)
+	})
+}
+
+func TestSliceDiff(t *testing.T) {
+	type args struct {
+		before []int
+		after  []int
+	}
+	type want struct {
+		added   []int
+		removed []int
+	}
+
+	stdtest.Table[args, want]{
+		"identical": {
+			Got:  args{before: []int{1, 2, 3}, after: []int{3, 2, 1}},
+			Want: want{},
+		},
+		"disjoint": {
+			Got:  args{before: []int{1, 2}, after: []int{3, 4}},
+			Want: want{added: []int{3, 4}, removed: []int{1, 2}},
+		},
+		"partial overlap": {
+			Got:  args{before: []int{1, 2, 3}, after: []int{2, 3, 4, 5}},
+			Want: want{added: []int{4, 5}, removed: []int{1}},
+		},
+		"empty inputs": {
+			Got:  args{before: []int{}, after: []int{}},
+			Want: want{},
+		},
+		"empty before": {
+			Got:  args{before: []int{}, after: []int{1}},
+			Want: want{added: []int{1}},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, want]) {
+		added, removed := stdlib.SliceDiff(tc.Got.before, tc.Got.after)
+		t.Equal(want{added: added, removed: removed}, tc.Want)
+	})
+}
+
+func TestSliceUnique(t *testing.T) {
+	stdtest.Table[[]string, []string]{
+		"empty":         {Got: []string{}, Want: []string{}},
+		"no duplicates": {Got: []string{"a", "b", "c"}, Want: []string{"a", "b", "c"}},
+		"duplicates":    {Got: []string{"b", "a", "b", "c", "a"}, Want: []string{"b", "a", "c"}},
+		"all the same":  {Got: []string{"a", "a", "a"}, Want: []string{"a"}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]string, []string]) {
+		t.Equal(stdlib.SliceUnique(tc.Got), tc.Want)
+		t.Equal(stdlib.SliceDistinct(tc.Got), tc.Want)
+		t.Equal(stdlib.SliceDistinctBy(tc.Got, func(s string) string { return s }), tc.Want)
+	})
+}
+
+func TestSliceDistinctBy(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	got := stdlib.SliceDistinctBy([]string{"apple", "avocado", "banana", "blueberry", "cherry"}, func(s string) byte { return s[0] })
+	test.Equal(got, []string{"apple", "banana", "cherry"})
+}
+
+func TestSliceFilterWithErrors(t *testing.T) {
+	even := func(i int) error {
+		if i%2 != 0 {
+			return fmt.Errorf("%d is odd", i)
+		}
+		return nil
+	}
+
+	type want struct {
+		output []int
+		errs   []string
+	}
+
+	stdtest.Table[[]int, want]{
+		"all pass": {
+			Got:  []int{2, 4},
+			Want: want{output: []int{2, 4}},
+		},
+		"all fail": {
+			Got:  []int{1, 3},
+			Want: want{errs: []string{"1 is odd", "3 is odd"}},
+		},
+		"mixed": {
+			Got:  []int{1, 2, 3, 4, 5},
+			Want: want{output: []int{2, 4}, errs: []string{"1 is odd", "3 is odd", "5 is odd"}},
+		},
+		"empty": {
+			Got:  []int{},
+			Want: want{},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]int, want]) {
+		output, errs := stdlib.SliceFilterWithErrors(tc.Got, even)
+		t.Equal(output, tc.Want.output)
+		t.Equal(len(errs), len(tc.Got)-len(output))
+		var got []string
+		for _, err := range errs {
+			got = append(got, err.Error())
+		}
+		t.Equal(got, tc.Want.errs)
+	})
+}
+
+func TestSliceMapWithIndex(t *testing.T) {
+	label := func(i int, s string) string { return strconv.Itoa(i) + ":" + s }
+
+	stdtest.Table[[]string, []string]{
+		"empty":  {Got: []string{}, Want: []string{}},
+		"single": {Got: []string{"a"}, Want: []string{"0:a"}},
+		"many":   {Got: []string{"a", "b", "c", "d"}, Want: []string{"0:a", "1:b", "2:c", "3:d"}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]string, []string]) {
+		t.Equal(stdlib.SliceMapWithIndex(tc.Got, label), tc.Want)
+	})
+}
+
+func TestSliceMapFilter(t *testing.T) {
+	evenSquares := func(i int) (string, bool) {
+		return strconv.Itoa(i * i), i%2 == 0
+	}
+
+	stdtest.Table[[]int, []string]{
+		"empty":        {Got: []int{}, Want: nil},
+		"all excluded": {Got: []int{1, 3, 5}, Want: nil},
+		"all included": {Got: []int{2, 4}, Want: []string{"4", "16"}},
+		"partial":      {Got: []int{1, 2, 3, 4}, Want: []string{"4", "16"}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]int, []string]) {
+		t.Equal(stdlib.SliceMapFilter(tc.Got, evenSquares), tc.Want)
+	})
+}
+
+func TestSliceNth(t *testing.T) {
+	input := []int{0, 1, 2, 3, 4, 5, 6}
+
+	type args struct {
+		input []int
+		n     int
+	}
+
+	stdtest.Table[args, []int]{
+		"n is one":     {Got: args{input: input, n: 1}, Want: input},
+		"n is two":     {Got: args{input: input, n: 2}, Want: []int{0, 2, 4, 6}},
+		"n is three":   {Got: args{input: input, n: 3}, Want: []int{0, 3, 6}},
+		"n beyond len": {Got: args{input: input, n: 10}, Want: []int{0}},
+		"empty":        {Got: args{input: []int{}, n: 2}, Want: []int{}},
+		"n is zero":    {Got: args{input: input, n: 0}, WantPanic: true},
+		"n negative":   {Got: args{input: input, n: -1}, WantPanic: true},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[args, []int]) {
+		got := stdlib.SliceNth(tc.Got.input, tc.Got.n)
+		t.Equal(got, tc.Want)
+	})
+}
+
+func TestSliceNth_Copy(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	input := []int{1, 2, 3}
+	got := stdlib.SliceNth(input, 1)
+	got[0] = 100
+	test.Equal(input, []int{1, 2, 3})
+}
+
+func TestSliceCountBy(t *testing.T) {
+	stdtest.Table[[]string, map[int]int]{
+		"empty": {Got: nil, Want: map[int]int{}},
+		"by length": {
+			Got:  []string{"a", "bb", "cc", "d", "eee"},
+			Want: map[int]int{1: 2, 2: 2, 3: 1},
+		},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]string, map[int]int]) {
+		t.Equal(stdlib.SliceCountBy(tc.Got, func(s string) int { return len(s) }), tc.Want)
+	})
+}
+
+func TestSliceFrequency(t *testing.T) {
+	stdtest.Table[[]string, map[string]int]{
+		"empty":      {Got: nil, Want: map[string]int{}},
+		"unique":     {Got: []string{"a", "b"}, Want: map[string]int{"a": 1, "b": 1}},
+		"duplicates": {Got: []string{"a", "b", "a", "a"}, Want: map[string]int{"a": 3, "b": 1}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]string, map[string]int]) {
+		t.Equal(stdlib.SliceFrequency(tc.Got), tc.Want)
+		t.Equal(stdlib.SliceFrequency(tc.Got), stdlib.SliceCountBy(tc.Got, func(s string) string { return s }))
+	})
+}
+
+func TestSliceCompact(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.SliceCompact([]stdlib.Error{errA, {}, errB, {}}), []stdlib.Error{errA, errB})
+	test.Equal(stdlib.SliceCompact([]stdlib.Error{{}, {}}), []stdlib.Error(nil))
+
+	tagged := stdlib.ErrorExtras{Tags: []string{"x"}}
+	test.Equal(stdlib.SliceCompact([]stdlib.ErrorExtras{{}, tagged, {}}), []stdlib.ErrorExtras{tagged})
+	test.Equal(stdlib.SliceCompact([]stdlib.ErrorExtras(nil)), []stdlib.ErrorExtras(nil))
+}
+
+func TestSliceCompactComparable(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.SliceCompactComparable([]int{0, 1, 0, 2, 3, 0}), []int{1, 2, 3})
+	test.Equal(stdlib.SliceCompactComparable([]int{0, 0}), []int(nil))
+	test.Equal(stdlib.SliceCompactComparable([]string{"", "a", "", "b"}), []string{"a", "b"})
+	test.Equal(stdlib.SliceCompactComparable([]string(nil)), []string(nil))
+}
+
+func TestSliceSortedInsert(t *testing.T) {
+	type item struct {
+		key   int
+		value string
+	}
+	sorted := []item{{1, "a"}, {3, "b"}, {3, "c"}, {5, "d"}}
+
+	stdtest.Table[item, []item]{
+		"start":     {Got: item{0, "x"}, Want: []item{{0, "x"}, {1, "a"}, {3, "b"}, {3, "c"}, {5, "d"}}},
+		"end":       {Got: item{9, "x"}, Want: []item{{1, "a"}, {3, "b"}, {3, "c"}, {5, "d"}, {9, "x"}}},
+		"middle":    {Got: item{4, "x"}, Want: []item{{1, "a"}, {3, "b"}, {3, "c"}, {4, "x"}, {5, "d"}}},
+		"duplicate": {Got: item{3, "x"}, Want: []item{{1, "a"}, {3, "b"}, {3, "c"}, {3, "x"}, {5, "d"}}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[item, []item]) {
+		got := stdlib.SliceSortedInsert(sorted, tc.Got, func(i item) int { return i.key })
+		t.Equal(got, tc.Want)
+		t.Equal(sorted, []item{{1, "a"}, {3, "b"}, {3, "c"}, {5, "d"}})
+	})
+}
+
+func TestSliceSortedInsert_Empty(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	test.Equal(stdlib.SliceSortedInsert(nil, 1, func(i int) int { return i }), []int{1})
+}
+
+func TestSliceAt(t *testing.T) {
+	type want struct {
+		value string
+		ok    bool
+	}
+	input := []string{"a", "b", "c"}
+
+	stdtest.Table[int, want]{
+		"first":    {Got: 0, Want: want{"a", true}},
+		"last":     {Got: 2, Want: want{"c", true}},
+		"negative": {Got: -1, Want: want{"", false}},
+		"len":      {Got: 3, Want: want{"", false}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[int, want]) {
+		value, ok := stdlib.SliceAt(input, tc.Got)
+		t.Equal(want{value, ok}, tc.Want)
+	})
+}
+
+func TestSliceAt_Empty(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	value, ok := stdlib.SliceAt([]int(nil), 0)
+	test.False(ok, "empty slice has no items")
+	test.Equal(value, 0)
+}
+
+func TestSliceUpdateAt(t *testing.T) {
+	input := []string{"a", "b", "c"}
+
+	stdtest.Table[int, []string]{
+		"start":  {Got: 0, Want: []string{"x", "b", "c"}},
+		"middle": {Got: 1, Want: []string{"a", "x", "c"}},
+		"end":    {Got: 2, Want: []string{"a", "b", "x"}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[int, []string]) {
+		got, err := stdlib.SliceUpdateAt(input, tc.Got, "x")
+		t.OK(err)
+		t.Equal(got, tc.Want)
+		t.Equal(input, []string{"a", "b", "c"})
+	})
+}
+
+func TestSliceUpdateAt_OutOfRange(t *testing.T) {
+	stdtest.Table[int, error]{
+		"negative": {Got: -1, Want: stdlib.ErrIndexOutOfRange},
+		"len":      {Got: 3, Want: stdlib.ErrIndexOutOfRange},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[int, error]) {
+		got, err := stdlib.SliceUpdateAt([]string{"a", "b", "c"}, tc.Got, "x")
+		t.EqualError(err, tc.Want)
+		t.Equal(got, []string(nil))
+	})
+}
+
+func TestSliceToMapFunc(t *testing.T) {
+	type item struct {
+		key   string
+		value int
+	}
+	key := func(i item) string { return i.key }
+	value := func(i item) int { return i.value * 10 }
+
+	stdtest.Table[[]item, map[string]int]{
+		"empty":           {Got: nil, Want: map[string]int{}},
+		"conversion":      {Got: []item{{"a", 1}, {"b", 2}}, Want: map[string]int{"a": 10, "b": 20}},
+		"last write wins": {Got: []item{{"a", 1}, {"b", 2}, {"a", 3}}, Want: map[string]int{"a": 30, "b": 20}},
+	}.Run(t, func(t *stdtest.Test, tc stdtest.Testcase[[]item, map[string]int]) {
+		t.Equal(stdlib.SliceToMapFunc(tc.Got, key, value), tc.Want)
+	})
+}
+
+func TestSliceCopyAndAppend(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	dst := make([]int, 2, 10)
+	dst[0], dst[1] = 1, 2
+
+	got := stdlib.SliceCopyAndAppend(dst, 3, 4)
+	test.Equal(got, []int{1, 2, 3, 4})
+	test.Equal(len(got), 4)
+
+	got[0] = 100
+	test.Equal(dst[0], 1)
+	test.Equal(dst[:3:3][2], 0)
+
+	test.Equal(stdlib.SliceCopyAndAppend([]int(nil)), []int{})
+	test.Equal(stdlib.SliceCopyAndAppend(nil, "a", "b"), []string{"a", "b"})
+}
@@ -0,0 +1,84 @@
+package stdlib
+
+import (
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+var _ KeyedRanger[int, any] = (*SortedMap[int, any])(nil)
+
+// NewSortedMap creates a new *SortedMap with the items from the given map.
+func NewSortedMap[K constraints.Ordered, V any](m map[K]V) *SortedMap[K, V] {
+	s := &SortedMap[K, V]{
+		items: make(map[K]V, len(m)),
+		keys:  make([]K, 0, len(m)),
+	}
+	for k, v := range m {
+		s.Set(k, v)
+	}
+	return s
+}
+
+// SortedMap is a key/value collection that iterates over its items
+// in ascending key order, regardless of insertion order.
+//
+// Keys are located with a binary search, so lookups are O(log N) while
+// inserts and deletes must also shift the sorted key slice.
+//
+// The zero value is ready to use. It is not safe for concurrent use
+// without external synchronization.
+type SortedMap[K constraints.Ordered, V any] struct {
+	// items maps keys to their values.
+	items map[K]V
+	// keys stored in ascending order.
+	keys []K
+}
+
+// Set stores the value for the key.
+func (s *SortedMap[K, V]) Set(key K, value V) {
+	if s.items == nil {
+		s.items = make(map[K]V)
+	}
+	if i, found := slices.BinarySearch(s.keys, key); !found {
+		s.keys = slices.Insert(s.keys, i, key)
+	}
+	s.items[key] = value
+}
+
+// Get returns the value for the key and true if it exists.
+func (s *SortedMap[K, V]) Get(key K) (V, bool) {
+	v, ok := s.items[key]
+	return v, ok
+}
+
+// Delete removes the key from the map.
+func (s *SortedMap[K, V]) Delete(key K) {
+	if i, found := slices.BinarySearch(s.keys, key); found {
+		s.keys = slices.Delete(s.keys, i, i+1)
+		delete(s.items, key)
+	}
+}
+
+// Keys returns a slice of all keys in ascending order.
+func (s *SortedMap[K, V]) Keys() []K {
+	return slices.Clone(s.keys)
+}
+
+// Len returns the number of items in the map.
+func (s *SortedMap[K, V]) Len() int {
+	return len(s.keys)
+}
+
+// Range calls the given function for each key/value pair in ascending key order.
+//
+// If the function returns `false`, iteration will stop.
+//
+// Interface: KeyedRanger.
+func (s *SortedMap[K, V]) Range(predicate KeyedPredicate[K, V]) {
+	for _, k := range s.keys {
+		if !predicate(k, s.items[k]) {
+			return
+		}
+	}
+}
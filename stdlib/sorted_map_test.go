@@ -0,0 +1,83 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+// sortedItems returns the keys and values of the map in iteration order.
+func sortedItems(s *stdlib.SortedMap[string, int]) ([]string, []int) {
+	var keys []string
+	var values []int
+	s.Range(func(k string, v int) bool {
+		keys = append(keys, k)
+		values = append(values, v)
+		return true
+	})
+	return keys, values
+}
+
+func TestSortedMap(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	s := stdlib.NewSortedMap(map[string]int{"c": 3, "a": 1, "b": 2})
+	test.Equal(s.Len(), 3)
+	test.Equal(s.Keys(), []string{"a", "b", "c"})
+
+	keys, values := sortedItems(s)
+	test.Equal(keys, []string{"a", "b", "c"})
+	test.Equal(values, []int{1, 2, 3})
+
+	v, ok := s.Get("b")
+	test.True(ok, "key must exist")
+	test.Equal(v, 2)
+	_, ok = s.Get("z")
+	test.False(ok, "key must not exist")
+}
+
+func TestSortedMap_InsertionOrder(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var forward, backward stdlib.SortedMap[string, int]
+	for i, k := range []string{"a", "b", "c", "d"} {
+		forward.Set(k, i)
+	}
+	for i, k := range []string{"d", "c", "b", "a"} {
+		backward.Set(k, 3-i)
+	}
+	backward.Set("b", 1)
+
+	fk, fv := sortedItems(&forward)
+	bk, bv := sortedItems(&backward)
+	test.Equal(fk, bk)
+	test.Equal(fv, bv)
+	test.Equal(backward.Len(), 4)
+}
+
+func TestSortedMap_Delete(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	s := stdlib.NewSortedMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+	s.Delete("b")
+	s.Delete("z")
+
+	keys, values := sortedItems(s)
+	test.Equal(keys, []string{"a", "c", "d"})
+	test.Equal(values, []int{1, 3, 4})
+	test.Equal(s.Len(), 3)
+}
+
+func TestSortedMap_RangeStop(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	s := stdlib.NewSortedMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+	var keys []string
+	s.Range(func(k string, _ int) bool {
+		keys = append(keys, k)
+		return k != "b"
+	})
+	test.Equal(keys, []string{"a", "b"})
+}
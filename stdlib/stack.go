@@ -0,0 +1,57 @@
+package stdlib
+
+// NewStack creates a new *Stack with the given items pushed in order,
+// leaving the last item on top.
+func NewStack[T any](items ...T) *Stack[T] {
+	s := &Stack[T]{items: make([]T, 0, len(items))}
+	for _, item := range items {
+		s.Push(item)
+	}
+	return s
+}
+
+// Stack is a last-in-first-out (LIFO) collection.
+//
+// It is not safe for concurrent use without external synchronization.
+type Stack[T any] struct {
+	// items stored in the stack with the top at the end.
+	items []T
+}
+
+// Push adds the item to the top of the stack.
+func (s *Stack[T]) Push(t T) {
+	s.items = append(s.items, t)
+}
+
+// Pop removes and returns the item from the top of the stack.
+//
+// If the stack is empty, the zero value and false are returned.
+func (s *Stack[T]) Pop() (T, bool) {
+	t, ok := s.Peek()
+	if !ok {
+		return t, false
+	}
+	s.items[len(s.items)-1] = *new(T)
+	s.items = s.items[:len(s.items)-1]
+	return t, true
+}
+
+// Peek returns the item from the top of the stack without removing it.
+//
+// If the stack is empty, the zero value and false are returned.
+func (s *Stack[T]) Peek() (T, bool) {
+	if s.IsEmpty() {
+		return *new(T), false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of items in the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// IsEmpty returns true if the stack has no items.
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
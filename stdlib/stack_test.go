@@ -0,0 +1,49 @@
+package stdlib_test
+
+import (
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestStack(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	s := stdlib.NewStack(1, 2)
+	s.Push(3)
+	test.Equal(s.Len(), 3)
+
+	top, ok := s.Peek()
+	test.True(ok, "non-empty stack must peek")
+	test.Equal(top, 3)
+	test.Equal(s.Len(), 3)
+
+	var got []int
+	for !s.IsEmpty() {
+		item, ok := s.Pop()
+		test.True(ok, "non-empty stack must pop")
+		got = append(got, item)
+	}
+	test.Equal(got, []int{3, 2, 1})
+}
+
+func TestStack_Empty(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	var s stdlib.Stack[string]
+	test.True(s.IsEmpty(), "zero value stack must be empty")
+
+	item, ok := s.Pop()
+	test.False(ok, "empty stack must not pop")
+	test.Equal(item, "")
+
+	item, ok = s.Peek()
+	test.False(ok, "empty stack must not peek")
+	test.Equal(item, "")
+
+	s.Push("a")
+	item, _ = s.Pop()
+	test.Equal(item, "a")
+	test.Equal(s.Len(), 0)
+}
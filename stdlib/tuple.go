@@ -0,0 +1,9 @@
+package stdlib
+
+// Tuple2 is a pair of values of different types.
+type Tuple2[A any, B any] struct {
+	// First value of the pair.
+	First A
+	// Second value of the pair.
+	Second B
+}
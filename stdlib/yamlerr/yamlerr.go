@@ -0,0 +1,88 @@
+// Package yamlerr provides YAML serialization of stdlib.Error values.
+package yamlerr
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlError is the YAML representation of a stdlib.Error.
+type yamlError struct {
+	Annotation  map[string]string  `yaml:"annotations,omitempty"`
+	Code        stdlib.ErrorCode   `yaml:"code,omitempty"`
+	CreatedAt   time.Time          `yaml:"created_at,omitempty"`
+	Extras      stdlib.ErrorExtras `yaml:"extras,omitempty"`
+	Flags       uint8              `yaml:"flags,omitempty"`
+	Message     string             `yaml:"message"`
+	Namespace   string             `yaml:"namespace,omitempty"`
+	UserMessage string             `yaml:"user_message,omitempty"`
+	Wrapped     *yamlError         `yaml:"wrapped,omitempty"`
+}
+
+// MarshalYAML returns the YAML encoding of the Error.
+//
+// The chain of wrapped errors is rendered as nested 'wrapped' fields. Wrapped
+// errors that are not a stdlib.Error only retain their message.
+func MarshalYAML(e stdlib.Error) ([]byte, error) {
+	return yaml.Marshal(fromError(e))
+}
+
+// UnmarshalYAML returns the Error decoded from the given YAML.
+//
+// Wrapped errors that are not a stdlib.Error are restored using 'errors.New'
+// with their message.
+func UnmarshalYAML(data []byte) (stdlib.Error, error) {
+	var ye yamlError
+	if err := yaml.Unmarshal(data, &ye); err != nil {
+		return stdlib.Error{}, err
+	}
+	return toError(&ye), nil
+}
+
+// fromError converts the Error and its wrapped chain to the YAML representation.
+func fromError(e stdlib.Error) *yamlError {
+	ye := &yamlError{
+		Annotation:  e.Annotation,
+		Code:        e.Code,
+		CreatedAt:   e.CreatedAt,
+		Extras:      e.Extras,
+		Flags:       uint8(e.Flags),
+		Message:     e.Message,
+		Namespace:   e.Namespace,
+		UserMessage: e.UserMessage,
+	}
+	if e.Wrapped != nil {
+		var we stdlib.Error
+		if errors.As(e.Wrapped, &we) {
+			ye.Wrapped = fromError(we)
+		} else {
+			ye.Wrapped = &yamlError{Message: e.Wrapped.Error()}
+		}
+	}
+	return ye
+}
+
+// toError converts the YAML representation and its wrapped chain to an Error.
+func toError(ye *yamlError) stdlib.Error {
+	e := stdlib.Error{
+		Annotation:  ye.Annotation,
+		Code:        ye.Code,
+		CreatedAt:   ye.CreatedAt,
+		Extras:      ye.Extras,
+		Flags:       stdlib.Bitmask(ye.Flags),
+		Message:     ye.Message,
+		Namespace:   ye.Namespace,
+		UserMessage: ye.UserMessage,
+	}
+	if w := ye.Wrapped; w != nil {
+		if w.Code == "" && w.Namespace == "" && w.Wrapped == nil {
+			e.Wrapped = errors.New(w.Message)
+		} else {
+			e.Wrapped = toError(w)
+		}
+	}
+	return e
+}
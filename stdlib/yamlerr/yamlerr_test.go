@@ -0,0 +1,43 @@
+package yamlerr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ahawker/stdlibx-go/stdlib"
+	"github.com/ahawker/stdlibx-go/stdlib/yamlerr"
+	"github.com/ahawker/stdlibx-go/stdtest"
+)
+
+func TestMarshalYAML(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	errA := stdlib.Error{Code: "a", Message: "error a", Namespace: "test"}
+	errB := stdlib.Error{Code: "b", Message: "error b", Namespace: "test"}
+
+	want := errA.
+		WithFlag(stdlib.ErrorFlagRetryable).
+		WithOperation("op").
+		WithTag("x").
+		Wrap(errB.Wrap(errors.New("io")))
+
+	b, err := yamlerr.MarshalYAML(want)
+	test.OK(err)
+
+	got, err := yamlerr.UnmarshalYAML(b)
+	test.OK(err)
+	test.True(got.Equal(want), "got %v, want %v", got, want)
+	test.Equal(got.Extras.Tags, []string{"x"})
+
+	wrapped, ok := got.Wrapped.(stdlib.Error)
+	test.True(ok, "wrapped Error must be restored: %#v", got.Wrapped)
+	test.True(wrapped.Equal(errB), "got %v, want %v", wrapped, errB)
+	test.Equal(wrapped.Wrapped.Error(), "io")
+}
+
+func TestUnmarshalYAML_Invalid(t *testing.T) {
+	test := stdtest.NewTest(t)
+
+	_, err := yamlerr.UnmarshalYAML([]byte("code: [a"))
+	test.NotOK(err)
+}